@@ -0,0 +1,4 @@
+data "shelly_kvs_entries" "garden" {
+  ip    = "192.168.1.100"
+  match = "garden.*"
+}
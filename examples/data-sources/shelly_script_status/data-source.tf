@@ -0,0 +1,8 @@
+data "shelly_script_status" "example" {
+  ip = shelly_script.example.ip
+  id = shelly_script.example.id
+}
+
+output "script_running" {
+  value = data.shelly_script_status.example.running
+}
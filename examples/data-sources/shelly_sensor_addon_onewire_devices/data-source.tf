@@ -0,0 +1,3 @@
+data "shelly_sensor_addon_onewire_devices" "example" {
+  ip = "192.168.1.100"
+}
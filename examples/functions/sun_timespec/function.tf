@@ -0,0 +1,4 @@
+# "@sunset-30m * * SAT,SUN"
+output "weekend_evenings" {
+  value = provider::shelly::sun_timespec("sunset", "-30m", ["SAT", "SUN"])
+}
@@ -0,0 +1,4 @@
+# "0 30 7 * * MON-FRI"
+output "weekday_mornings" {
+  value = provider::shelly::timespec("07:30", ["MON-FRI"])
+}
@@ -8,4 +8,7 @@ terraform {
 
 provider "shelly" {
   # Device IP has to be set in each resource or data source
-}
\ No newline at end of file
+
+  # Password of the devices with authentication enabled
+  password = var.device_password
+}
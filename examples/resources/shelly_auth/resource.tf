@@ -0,0 +1,14 @@
+resource "shelly_auth" "living_room" {
+  ip               = "192.168.1.100"
+  password         = var.device_password
+  password_version = 1
+}
+
+# Referencing the resource makes the script wait for the password to be
+# set, and then reach the device with it.
+resource "shelly_script" "motion" {
+  ip     = shelly_auth.living_room.ip
+  name   = "motion"
+  code   = file("${path.module}/motion.js")
+  enable = true
+}
@@ -0,0 +1,6 @@
+resource "shelly_ble_config" "example" {
+  ip              = "192.168.1.100"
+  enable          = false
+  rpc_enable      = false
+  observer_enable = false
+}
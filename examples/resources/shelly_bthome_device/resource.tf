@@ -0,0 +1,6 @@
+resource "shelly_bthome_device" "front_door" {
+  ip   = "192.168.1.100"
+  addr = "7c:c6:b6:00:00:01"
+  name = "Front door"
+  key  = var.front_door_key
+}
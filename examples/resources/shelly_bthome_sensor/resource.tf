@@ -0,0 +1,16 @@
+# Window state of a Shelly BLU Door/Window.
+resource "shelly_bthome_sensor" "front_door_window" {
+  ip     = shelly_bthome_device.front_door.ip
+  addr   = shelly_bthome_device.front_door.addr
+  obj_id = 45
+  name   = "Front door open"
+}
+
+# Second button of a Shelly BLU RC Button 4.
+resource "shelly_bthome_sensor" "remote_button_2" {
+  ip     = "192.168.1.100"
+  addr   = "7c:c6:b6:00:00:02"
+  obj_id = 58
+  idx    = 1
+  name   = "Remote button 2"
+}
@@ -0,0 +1,8 @@
+resource "shelly_cct_config" "example" {
+  ip                       = "192.168.1.100"
+  id                       = 0
+  name                     = "Bedroom Bulb"
+  initial_state            = "restore_last"
+  min_brightness_on_toggle = 20
+  ct_range                 = [2700, 6500]
+}
@@ -0,0 +1,8 @@
+resource "shelly_cloud_config" "example" {
+  ip     = "192.168.1.100"
+  enable = false
+}
+
+output "cloud_connected" {
+  value = shelly_cloud_config.example.connected
+}
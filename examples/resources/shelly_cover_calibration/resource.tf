@@ -0,0 +1,8 @@
+resource "shelly_cover_calibration" "example" {
+  ip = "192.168.1.100"
+  id = 0
+
+  triggers = {
+    installed = "2025-03-14"
+  }
+}
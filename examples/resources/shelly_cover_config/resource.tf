@@ -0,0 +1,23 @@
+resource "shelly_cover_config" "example" {
+  ip            = "192.168.1.100"
+  id            = 0
+  name          = "Living Room Shutter"
+  in_mode       = "dual"
+  initial_state = "stopped"
+  power_limit   = 300
+  maxtime_open  = 60
+  maxtime_close = 60
+
+  motor = {
+    idle_power_thr      = 2
+    idle_confirm_period = 0.25
+  }
+
+  obstruction_detection = {
+    enable    = true
+    direction = "close"
+    action    = "reverse"
+    power_thr = 150
+    holdoff   = 1
+  }
+}
@@ -0,0 +1,7 @@
+resource "shelly_em1_config" "example" {
+  ip      = "192.168.1.100"
+  id      = 0
+  name    = "Heat Pump"
+  ct_type = "50A"
+  reverse = false
+}
@@ -0,0 +1,14 @@
+resource "shelly_em_config" "example" {
+  ip                     = "192.168.1.100"
+  id                     = 0
+  name                   = "Main Panel"
+  ct_type                = "120A"
+  monitor_phase_sequence = true
+  phase_selector         = "all"
+
+  reverse = {
+    a = false
+    b = false
+    c = true
+  }
+}
@@ -0,0 +1,9 @@
+resource "shelly_eth_config" "example" {
+  ip         = "192.168.1.100"
+  enable     = true
+  ipv4mode   = "static"
+  static_ip  = "192.168.1.100"
+  netmask    = "255.255.255.0"
+  gw         = "192.168.1.1"
+  nameserver = "192.168.1.1"
+}
@@ -0,0 +1,8 @@
+resource "shelly_humidity_config" "example" {
+  ip         = "192.168.1.120"
+  id         = 0
+  name       = "Living Room"
+  report_thr = 2
+  offset     = 1.5
+  sleepy     = true
+}
@@ -0,0 +1,7 @@
+resource "shelly_illuminance_config" "example" {
+  ip         = "192.168.1.100"
+  id         = 0
+  name       = "Hallway"
+  dark_thr   = 50
+  bright_thr = 500
+}
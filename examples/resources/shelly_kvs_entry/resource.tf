@@ -0,0 +1,14 @@
+resource "shelly_kvs_entry" "mqtt_topic" {
+  ip    = "192.168.1.100"
+  key   = "garden.topic"
+  value = "garden/lights"
+}
+
+resource "shelly_kvs_entry" "thresholds" {
+  ip  = "192.168.1.100"
+  key = "garden.thresholds"
+  value_json = jsonencode({
+    lux_on  = 40
+    lux_off = 120
+  })
+}
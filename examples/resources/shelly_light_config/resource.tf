@@ -0,0 +1,22 @@
+resource "shelly_light_config" "example" {
+  ip                       = "192.168.1.100"
+  id                       = 0
+  name                     = "Hallway"
+  initial_state            = "restore_last"
+  transition_duration      = 1.5
+  min_brightness_on_toggle = 10
+
+  night_mode = {
+    enable         = true
+    brightness     = 5
+    active_between = ["22:00", "06:30"]
+  }
+
+  button_fade_rate = 3
+
+  button_presets = {
+    button_doublepush = {
+      brightness = 100
+    }
+  }
+}
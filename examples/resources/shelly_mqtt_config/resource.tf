@@ -0,0 +1,17 @@
+variable "mqtt_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "shelly_mqtt_config" "example" {
+  ip              = "192.168.1.100"
+  enable          = true
+  server          = "broker.local:1883"
+  user            = "shelly"
+  pass            = var.mqtt_password
+  pass_wo_version = 1
+  topic_prefix    = "shellies/living-room"
+  rpc_ntf         = true
+  status_ntf      = true
+  enable_control  = true
+}
@@ -0,0 +1,20 @@
+variable "wifi_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "shelly_onboarding" "example" {
+  ssid      = "HomeNetwork"
+  pass      = var.wifi_password
+  ipv4mode  = "static"
+  static_ip = "192.168.1.120"
+  netmask   = "255.255.255.0"
+  gw        = "192.168.1.1"
+  name      = "Garage Door"
+}
+
+resource "shelly_switch_config" "garage" {
+  ip   = shelly_onboarding.example.address
+  id   = 0
+  name = "Garage Door"
+}
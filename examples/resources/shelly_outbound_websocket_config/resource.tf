@@ -0,0 +1,9 @@
+resource "shelly_outbound_websocket_config" "example" {
+  ip     = "192.168.1.100"
+  enable = true
+  server = "ws://192.168.1.10:8123/api/shelly/ws"
+}
+
+output "websocket_connected" {
+  value = shelly_outbound_websocket_config.example.connected
+}
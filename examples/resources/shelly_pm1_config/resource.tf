@@ -0,0 +1,5 @@
+resource "shelly_pm1_config" "example" {
+  ip   = "192.168.1.100"
+  id   = 0
+  name = "Dishwasher"
+}
@@ -0,0 +1,13 @@
+resource "shelly_rgb_config" "example" {
+  ip                  = "192.168.1.100"
+  id                  = 0
+  name                = "Kitchen Strip"
+  initial_state       = "off"
+  transition_duration = 2
+
+  night_mode = {
+    enable         = true
+    brightness     = 10
+    active_between = ["23:00", "06:00"]
+  }
+}
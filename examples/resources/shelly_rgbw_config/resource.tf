@@ -0,0 +1,9 @@
+resource "shelly_rgbw_config" "example" {
+  ip                  = "192.168.1.100"
+  id                  = 0
+  name                = "Living Room Strip"
+  initial_state       = "restore_last"
+  auto_off            = true
+  auto_off_delay      = 3600
+  transition_duration = 1
+}
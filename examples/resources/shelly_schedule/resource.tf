@@ -0,0 +1,23 @@
+# Turn on the first switch at 07:00 on weekdays.
+resource "shelly_schedule" "morning" {
+  ip       = "192.168.1.100"
+  timespec = "0 0 7 * * MON-FRI"
+  calls = [
+    {
+      method = "Switch.Set"
+      params = jsonencode({ id = 0, on = true })
+    },
+  ]
+}
+
+# Turn the first switch off 30 minutes after sunset every day.
+resource "shelly_schedule" "evening" {
+  ip       = "192.168.1.100"
+  timespec = provider::shelly::sun_timespec("sunset", "30m", [])
+  calls = [
+    {
+      method = "Switch.Set"
+      params = jsonencode({ id = 0, on = false })
+    },
+  ]
+}
@@ -0,0 +1,26 @@
+resource "shelly_script" "example" {
+  ip     = "192.168.1.100"
+  name   = "auto-off"
+  code   = file("${path.module}/auto-off.js")
+  enable = true
+  start  = true
+
+  # Fail the apply if the script crashes within ten seconds of starting.
+  require_running      = true
+  running_grace_period = 10
+}
+
+# Bundle shared helpers with the main script and pass in settings.
+resource "shelly_script" "bundled" {
+  ip   = "192.168.1.100"
+  name = "garden-lights"
+  files = [
+    "${path.module}/lib/helpers.js",
+    "${path.module}/garden-lights.js",
+  ]
+  vars = {
+    MQTT_TOPIC = "garden/lights"
+  }
+  minify = true
+  start  = true
+}
@@ -0,0 +1,18 @@
+data "shelly_sensor_addon_onewire_devices" "bus" {
+  ip = "192.168.1.100"
+}
+
+# Add every DS18B20 found on the bus.
+resource "shelly_sensor_addon_peripheral" "ds18b20" {
+  for_each = { for d in data.shelly_sensor_addon_onewire_devices.bus.devices : d.addr => d }
+
+  ip   = "192.168.1.100"
+  type = "ds18b20"
+  addr = each.key
+}
+
+resource "shelly_sensor_addon_peripheral" "tank_level" {
+  ip   = "192.168.1.100"
+  type = "voltmeter"
+  cid  = 100
+}
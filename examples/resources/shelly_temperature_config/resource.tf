@@ -0,0 +1,13 @@
+# An H&T Gen3 is asleep most of the time. Changes wait up to ten minutes for
+# it to wake up, and are pushed as soon as it opens its outbound WebSocket
+# connection to ws://<provider host>:8765.
+resource "shelly_temperature_config" "example" {
+  ip                  = "192.168.1.120"
+  id                  = 0
+  name                = "Living Room"
+  report_thr_c        = 0.5
+  offset_c            = -0.3
+  sleepy              = true
+  wake_timeout        = 600
+  wake_listen_address = ":8765"
+}
@@ -0,0 +1,7 @@
+resource "shelly_virtual_boolean" "away" {
+  ip        = "192.168.1.100"
+  name      = "Away mode"
+  view      = "toggle"
+  titles    = ["Home", "Away"]
+  persisted = true
+}
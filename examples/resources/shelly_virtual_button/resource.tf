@@ -0,0 +1,4 @@
+resource "shelly_virtual_button" "all_off" {
+  ip   = "192.168.1.100"
+  name = "All off"
+}
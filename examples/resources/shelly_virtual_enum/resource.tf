@@ -0,0 +1,11 @@
+resource "shelly_virtual_enum" "mode" {
+  ip      = "192.168.1.100"
+  name    = "Mode"
+  options = ["auto", "manual", "off"]
+  titles = {
+    auto   = "Automatic"
+    manual = "Manual"
+    off    = "Off"
+  }
+  default_value = "auto"
+}
@@ -0,0 +1,8 @@
+resource "shelly_virtual_group" "heating" {
+  ip   = "192.168.1.100"
+  name = "Heating"
+  members = [
+    "boolean:${shelly_virtual_boolean.away.id}",
+    "number:${shelly_virtual_number.target_temperature.id}",
+  ]
+}
@@ -0,0 +1,10 @@
+resource "shelly_virtual_number" "target_temperature" {
+  ip            = "192.168.1.100"
+  name          = "Target temperature"
+  view          = "slider"
+  unit          = "°C"
+  step          = 0.5
+  min           = 5
+  max           = 30
+  default_value = 21
+}
@@ -0,0 +1,6 @@
+resource "shelly_virtual_text" "status" {
+  ip      = "192.168.1.100"
+  name    = "Status"
+  view    = "label"
+  max_len = 64
+}
@@ -0,0 +1,12 @@
+# Sensor Add-on analog input measuring a tank level sensor.
+resource "shelly_voltmeter_config" "example" {
+  ip         = "192.168.1.100"
+  id         = 100
+  name       = "Water Tank"
+  report_thr = 0.1
+
+  xvoltage = {
+    expr = "x*10"
+    unit = "%"
+  }
+}
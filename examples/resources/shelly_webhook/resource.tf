@@ -0,0 +1,19 @@
+# Tell Node-RED when the first switch turns on at night.
+resource "shelly_webhook" "example" {
+  ip             = "192.168.1.100"
+  cid            = 0
+  event          = "switch.on"
+  name           = "node-red"
+  urls           = ["http://node-red.local:1880/shelly?device=$${config.sys.device.name}"]
+  active_between = ["22:00", "06:00"]
+}
+
+# Report high temperatures at most every ten minutes.
+resource "shelly_webhook" "too_hot" {
+  ip            = "192.168.1.100"
+  cid           = 100
+  event         = "temperature.change"
+  urls          = ["http://node-red.local:1880/too-hot?t=$${ev.tC}"]
+  condition     = "ev.tC > 30"
+  repeat_period = 600
+}
@@ -0,0 +1,26 @@
+variable "wifi_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "shelly_wifi_config" "example" {
+  ip = "192.168.1.100"
+
+  sta = {
+    enable   = true
+    ssid     = "HomeNetwork"
+    pass     = var.wifi_password
+    ipv4mode = "dhcp"
+  }
+
+  ap = {
+    enable = false
+  }
+
+  roam = {
+    rssi_thr = -80
+    interval = 60
+  }
+
+  pass_wo_version = 1
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// optionalFloat64Attribute returns an optional, computed number attribute
+// that must not be negative.
+func optionalFloat64Attribute(description string) schema.Float64Attribute {
+	return schema.Float64Attribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: description,
+		Validators: []validator.Float64{
+			float64validator.AtLeast(0),
+		},
+		PlanModifiers: []planmodifier.Float64{
+			float64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// percentAttribute returns an optional, computed number attribute in the
+// range 0-100, used for brightness and similar levels.
+func percentAttribute(description string) schema.Float64Attribute {
+	return schema.Float64Attribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: description,
+		Validators: []validator.Float64{
+			float64validator.Between(0, 100),
+		},
+		PlanModifiers: []planmodifier.Float64{
+			float64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// signedFloat64Attribute returns an optional, computed number attribute that
+// may be negative, used for calibration offsets.
+func signedFloat64Attribute(description string) schema.Float64Attribute {
+	return schema.Float64Attribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: description,
+		PlanModifiers: []planmodifier.Float64{
+			float64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
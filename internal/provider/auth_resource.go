@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"resty.dev/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &authResource{}
+	_ resource.ResourceWithConfigure   = &authResource{}
+	_ resource.ResourceWithImportState = &authResource{}
+)
+
+func NewAuthResource() resource.Resource {
+	return &authResource{}
+}
+
+type authResourceModel struct {
+	IP              types.String `tfsdk:"ip"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+	Realm           types.String `tfsdk:"realm"`
+}
+
+type authResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *authResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth"
+}
+
+func (c *authResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *authResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Protects the local API of a device with a password. Destroying the resource turns authentication off again. " +
+			"Resources depending on this one use the new password for the rest of the apply; set the provider `password` for later runs.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Required:            true,
+				WriteOnly:           true,
+				Sensitive:           true,
+				MarkdownDescription: "Password of the `admin` user. It is not stored in state, so changes only take effect when `password_version` changes.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Change to set a new `password` on the device.",
+			},
+			"realm": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The authentication realm, which is the device ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// readAuth refreshes state from the device and reports whether
+// authentication is enabled. Shelly.GetDeviceInfo does not need
+// authentication, so this works with any password.
+func readAuth(client *resty.Client, state *authResourceModel) (bool, error) {
+	info, err := getDeviceInfo(client)
+	if err != nil {
+		return false, err
+	}
+	state.Realm = types.StringValue(info.ID)
+	if info.AuthDomain != nil {
+		state.Realm = types.StringValue(*info.AuthDomain)
+	}
+	return info.AuthEn, nil
+}
+
+// setAuth sets the password of the device, or turns authentication off if
+// password is nil. The realm must be the device ID.
+func setAuth(client *resty.Client, realm string, password *string) error {
+	params := map[string]any{
+		"user":  authUser,
+		"realm": realm,
+		"ha1":   nil,
+	}
+	if password != nil {
+		params["ha1"] = authHA1(authUser, realm, *password)
+	}
+	return callRPC(client, "Shelly.SetAuth", params, nil)
+}
+
+// setDeviceAuth sets password on the device in plan and switches to it for
+// the rest of the run.
+func setDeviceAuth(credentials *deviceCredentials, plan *authResourceModel, password string, diags *diag.Diagnostics) error {
+	ip := plan.IP.ValueString()
+	client := newDeviceClient(credentials, ip)
+	defer client.Close()
+
+	// The realm is the device ID, so it has to be queried first.
+	enabled, err := readAuth(client, plan)
+	if err != nil {
+		diags.AddError("Failed to query device info", err.Error())
+		return err
+	}
+	if err := setAuth(client, plan.Realm.ValueString(), &password); err != nil {
+		detail := err.Error()
+		if enabled {
+			detail += ". The device already has a password; set the provider password to it."
+		}
+		diags.AddError("Failed to set device password", detail)
+		return err
+	}
+	credentials.setPassword(ip, password)
+	return nil
+}
+
+func (c *authResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state authResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	enabled, err := readAuth(client, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query device info", err.Error())
+		return
+	}
+	// Authentication was turned off outside of Terraform.
+	if !enabled {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *authResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config authResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	// The password is write-only and therefore only part of the config.
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := setDeviceAuth(c.credentials, &plan, config.Password.ValueString(), &resp.Diagnostics); err != nil {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *authResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config authResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := setDeviceAuth(c.credentials, &plan, config.Password.ValueString(), &resp.Diagnostics); err != nil {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *authResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ip"), req, resp)
+}
+
+func (c *authResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state authResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ip := state.IP.ValueString()
+	client := newDeviceClient(c.credentials, ip)
+	defer client.Close()
+
+	if err := setAuth(client, state.Realm.ValueString(), nil); err != nil {
+		resp.Diagnostics.AddError("Failed to turn off authentication",
+			err.Error()+". Turning it off needs the current password, so the provider password must be set to it.")
+		return
+	}
+	c.credentials.setPassword(ip, "")
+	resp.State.RemoveResource(ctx)
+}
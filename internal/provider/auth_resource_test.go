@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAuthDevice answers Shelly.GetDeviceInfo and Shelly.SetAuth and
+// records the parameters of the last Shelly.SetAuth call.
+type fakeAuthDevice struct {
+	authEn  bool
+	setAuth map[string]any
+}
+
+func (d *fakeAuthDevice) handle(method string, params json.RawMessage) (any, *rpcError) {
+	switch method {
+	case "Shelly.GetDeviceInfo":
+		return deviceInfo{ID: "shellyplus1pm-a8032ab12345", Gen: 2, AuthEn: d.authEn}, nil
+	case "Shelly.SetAuth":
+		d.setAuth = map[string]any{}
+		_ = json.Unmarshal(params, &d.setAuth)
+		d.authEn = d.setAuth["ha1"] != nil
+		return nil, nil
+	}
+	return nil, &rpcError{Code: 404, Message: "No handler for " + method}
+}
+
+func TestSetDeviceAuth(t *testing.T) {
+	device := &fakeAuthDevice{}
+	ip := fakeDeviceIP(t, device.handle)
+	credentials := newDeviceCredentials("default")
+
+	plan := authResourceModel{IP: types.StringValue(ip)}
+	var diags diag.Diagnostics
+	require.NoError(t, setDeviceAuth(credentials, &plan, "secret", &diags))
+	require.False(t, diags.HasError())
+
+	require.Equal(t, "shellyplus1pm-a8032ab12345", plan.Realm.ValueString())
+	require.Equal(t, map[string]any{
+		"user":  "admin",
+		"realm": "shellyplus1pm-a8032ab12345",
+		"ha1":   authHA1("admin", "shellyplus1pm-a8032ab12345", "secret"),
+	}, device.setAuth)
+	require.True(t, device.authEn)
+	// Later resources reach the device with the new password.
+	require.Equal(t, "secret", credentials.password(ip))
+}
+
+func TestAuthResourceDelete(t *testing.T) {
+	device := &fakeAuthDevice{authEn: true}
+	ip := fakeDeviceIP(t, device.handle)
+	credentials := newDeviceCredentials("default")
+	credentials.setPassword(ip, "secret")
+
+	res := NewAuthResource()
+	var configureResp resource.ConfigureResponse
+	res.(resource.ResourceWithConfigure).Configure(context.Background(),
+		resource.ConfigureRequest{ProviderData: credentials}, &configureResp)
+	require.False(t, configureResp.Diagnostics.HasError())
+
+	state := resourceConfig(t, res, map[string]tftypes.Value{
+		"ip":    tftypes.NewValue(tftypes.String, ip),
+		"realm": tftypes.NewValue(tftypes.String, "shellyplus1pm-a8032ab12345"),
+	})
+	resp := resource.DeleteResponse{State: tfsdk.State{Schema: state.Schema, Raw: state.Raw}}
+	res.Delete(context.Background(), resource.DeleteRequest{
+		State: tfsdk.State{Schema: state.Schema, Raw: state.Raw},
+	}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	require.Contains(t, device.setAuth, "ha1")
+	require.Nil(t, device.setAuth["ha1"])
+	require.Equal(t, "shellyplus1pm-a8032ab12345", device.setAuth["realm"])
+	require.False(t, device.authEn)
+	require.True(t, resp.State.Raw.IsNull())
+	// The device falls back to the provider password.
+	require.Equal(t, "default", credentials.password(ip))
+}
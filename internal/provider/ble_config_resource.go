@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &bleConfigResource{}
+	_ resource.ResourceWithConfigure   = &bleConfigResource{}
+	_ resource.ResourceWithImportState = &bleConfigResource{}
+)
+
+func NewBLEConfigResource() resource.Resource {
+	return &bleConfigResource{}
+}
+
+type bleConfigResourceModel struct {
+	IP             types.String `tfsdk:"ip"`
+	Enable         types.Bool   `tfsdk:"enable"`
+	RPCEnable      types.Bool   `tfsdk:"rpc_enable"`
+	ObserverEnable types.Bool   `tfsdk:"observer_enable"`
+	Reboot         types.Bool   `tfsdk:"reboot"`
+}
+
+// bleConfig mirrors the config object of BLE.GetConfig / BLE.SetConfig.
+type bleConfig struct {
+	Enable   *bool            `json:"enable,omitempty"`
+	RPC      *bleEnableConfig `json:"rpc,omitempty"`
+	Observer *bleEnableConfig `json:"observer,omitempty"`
+}
+
+type bleEnableConfig struct {
+	Enable *bool `json:"enable,omitempty"`
+}
+
+type bleConfigResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *bleConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ble_config"
+}
+
+func (c *bleConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *bleConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+			},
+			"enable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if Bluetooth is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rpc_enable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if RPC over Bluetooth is enabled (`rpc.enable`).",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"observer_enable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if the device listens to BTHome advertisements (`observer.enable`).",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reboot": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Reboot the device when the change requires it, so that it takes effect right away. Defaults to true.",
+			},
+		},
+	}
+}
+
+func readBLEConfig(credentials *deviceCredentials, state *bleConfigResourceModel) error {
+	client := newDeviceClient(credentials, state.IP.ValueString())
+	defer client.Close()
+
+	var config bleConfig
+	if err := callRPC(client, "BLE.GetConfig", nil, &config); err != nil {
+		return err
+	}
+	state.Enable = types.BoolPointerValue(config.Enable)
+	state.RPCEnable = types.BoolNull()
+	if config.RPC != nil {
+		state.RPCEnable = types.BoolPointerValue(config.RPC.Enable)
+	}
+	state.ObserverEnable = types.BoolNull()
+	if config.Observer != nil {
+		state.ObserverEnable = types.BoolPointerValue(config.Observer.Enable)
+	}
+	return nil
+}
+
+func (c *bleConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bleConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := readBLEConfig(c.credentials, &state); err != nil {
+		resp.Diagnostics.AddError("Failed to query BLE config", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func setBLEConfig(ctx context.Context, credentials *deviceCredentials, plan bleConfigResourceModel, diags *diag.Diagnostics) error {
+	ble := bleConfig{
+		Enable: boolPointer(plan.Enable),
+	}
+	if rpcEnable := boolPointer(plan.RPCEnable); rpcEnable != nil {
+		ble.RPC = &bleEnableConfig{Enable: rpcEnable}
+	}
+	if observerEnable := boolPointer(plan.ObserverEnable); observerEnable != nil {
+		ble.Observer = &bleEnableConfig{Enable: observerEnable}
+	}
+
+	client := newDeviceClient(credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	var result setConfigResult
+	if err := callRPC(client, "BLE.SetConfig", map[string]any{"config": ble}, &result); err != nil {
+		diags.AddError("Failed to set BLE config", err.Error())
+		return err
+	}
+
+	if !result.RestartRequired {
+		return nil
+	}
+	if !plan.Reboot.ValueBool() {
+		diags.AddWarning("Reboot required", "The BLE config takes effect after the device is rebooted.")
+		return nil
+	}
+	if err := rebootDevice(ctx, credentials, plan.IP.ValueString()); err != nil {
+		diags.AddError("Failed to reboot device", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (c *bleConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bleConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setBLEConfig(ctx, c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readBLEConfig(c.credentials, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query BLE config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *bleConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan bleConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setBLEConfig(ctx, c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readBLEConfig(c.credentials, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query BLE config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *bleConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ip"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reboot"), true)...)
+}
+
+func (c *bleConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
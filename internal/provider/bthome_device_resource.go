@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"resty.dev/v3"
+)
+
+var (
+	// bleAddressRegexp matches BLE MAC addresses in either case. The device
+	// reports them in lowercase.
+	bleAddressRegexp = regexp.MustCompile(`(?i)^([0-9a-f]{2}:){5}[0-9a-f]{2}$`)
+	// bthomeKeyRegexp matches AES-128 encryption keys.
+	bthomeKeyRegexp = regexp.MustCompile(`^[0-9a-fA-F]{32}$`)
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &bthomeDeviceResource{}
+	_ resource.ResourceWithConfigure   = &bthomeDeviceResource{}
+	_ resource.ResourceWithImportState = &bthomeDeviceResource{}
+)
+
+func NewBTHomeDeviceResource() resource.Resource {
+	return &bthomeDeviceResource{}
+}
+
+type bthomeDeviceResourceModel struct {
+	IP   types.String `tfsdk:"ip"`
+	ID   types.Int32  `tfsdk:"id"`
+	Addr types.String `tfsdk:"addr"`
+	Name types.String `tfsdk:"name"`
+	Key  types.String `tfsdk:"key"`
+}
+
+// bthomeDeviceConfig mirrors the config object of BTHomeDevice.GetConfig /
+// BTHomeDevice.SetConfig. The address can only be set when adding the
+// device, and a null key turns off decryption.
+type bthomeDeviceConfig struct {
+	Addr string  `json:"addr,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Key  *string `json:"key"`
+}
+
+type bthomeDeviceResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *bthomeDeviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bthome_device"
+}
+
+func (c *bthomeDeviceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+// bthomeIDAttribute returns the id attribute of the BTHome components.
+func bthomeIDAttribute(component string) schema.Int32Attribute {
+	return schema.Int32Attribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: fmt.Sprintf("The ID of the %s component, from %d to %d. Assigned by the device if not set.", component, dynamicMinID, dynamicMaxID),
+		PlanModifiers: []planmodifier.Int32{
+			int32planmodifier.UseStateForUnknown(),
+			int32planmodifier.RequiresReplaceIfConfigured(),
+		},
+		Validators: []validator.Int32{
+			int32validator.Between(dynamicMinID, dynamicMaxID),
+		},
+	}
+}
+
+func (c *bthomeDeviceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Pairs a BTHome device, such as a Shelly BLU sensor, with a gateway device. Its readings are added with `shelly_bthome_sensor`.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly gateway device.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": bthomeIDAttribute("BTHomeDevice"),
+			"addr": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The BLE MAC address of the device, e.g. `aa:bb:cc:dd:ee:ff`. It is sent to the device in lowercase.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(bleAddressRegexp, "must be a MAC address such as aa:bb:cc:dd:ee:ff"),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the device.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The AES encryption key of the device as 32 hex digits, for devices with encryption enabled.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(bthomeKeyRegexp, "must be 32 hex digits"),
+				},
+			},
+		},
+	}
+}
+
+// addBTHomeComponent adds a component with method, e.g. BTHome.AddDevice,
+// and returns its ID. id requests a specific ID unless it is null or
+// unknown.
+func addBTHomeComponent(client *resty.Client, method string, id types.Int32, config any) (int32, error) {
+	params := map[string]any{"config": config}
+	if !id.IsNull() && !id.IsUnknown() {
+		params["id"] = id.ValueInt32()
+	}
+	var result struct {
+		Key string `json:"key"`
+	}
+	if err := callRPC(client, method, params, &result); err != nil {
+		return 0, err
+	}
+	return componentCID(result.Key)
+}
+
+// readBTHomeDevice refreshes state from the device. The address and key are
+// kept as configured if the device reports them in another case, and the key
+// also if the device does not report it.
+func readBTHomeDevice(client *resty.Client, state *bthomeDeviceResourceModel) error {
+	var config bthomeDeviceConfig
+	if err := callRPC(client, "BTHomeDevice.GetConfig", map[string]any{"id": state.ID.ValueInt32()}, &config); err != nil {
+		return err
+	}
+	if !strings.EqualFold(config.Addr, state.Addr.ValueString()) {
+		state.Addr = types.StringValue(config.Addr)
+	}
+	state.Name = types.StringPointerValue(config.Name)
+	if config.Key != nil && !strings.EqualFold(*config.Key, state.Key.ValueString()) {
+		state.Key = types.StringValue(*config.Key)
+	}
+	return nil
+}
+
+func (c *bthomeDeviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bthomeDeviceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	err := readBTHomeDevice(client, &state)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query BTHomeDevice config", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *bthomeDeviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bthomeDeviceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	config := bthomeDeviceConfig{
+		Addr: strings.ToLower(plan.Addr.ValueString()),
+		Name: stringPointer(plan.Name),
+		Key:  stringPointer(plan.Key),
+	}
+	id, err := addBTHomeComponent(client, "BTHome.AddDevice", plan.ID, config)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add BTHome device", err.Error())
+		return
+	}
+	plan.ID = types.Int32Value(id)
+	if err := readBTHomeDevice(client, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query BTHomeDevice config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *bthomeDeviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan bthomeDeviceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	config := bthomeDeviceConfig{
+		Name: stringPointer(plan.Name),
+		Key:  stringPointer(plan.Key),
+	}
+	params := map[string]any{"id": plan.ID.ValueInt32(), "config": config}
+	if err := callRPC(client, "BTHomeDevice.SetConfig", params, nil); err != nil {
+		resp.Diagnostics.AddError("Failed to set BTHomeDevice config", err.Error())
+		return
+	}
+	if err := readBTHomeDevice(client, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query BTHomeDevice config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *bthomeDeviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importDynamicComponentState(ctx, req, resp, "BTHomeDevice")
+}
+
+func (c *bthomeDeviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bthomeDeviceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	if err := callRPC(client, "BTHome.DeleteDevice", map[string]any{"id": state.ID.ValueInt32()}, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete BTHome device", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"resty.dev/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &bthomeSensorResource{}
+	_ resource.ResourceWithConfigure   = &bthomeSensorResource{}
+	_ resource.ResourceWithImportState = &bthomeSensorResource{}
+)
+
+func NewBTHomeSensorResource() resource.Resource {
+	return &bthomeSensorResource{}
+}
+
+type bthomeSensorResourceModel struct {
+	IP    types.String `tfsdk:"ip"`
+	ID    types.Int32  `tfsdk:"id"`
+	Addr  types.String `tfsdk:"addr"`
+	ObjID types.Int64  `tfsdk:"obj_id"`
+	Idx   types.Int64  `tfsdk:"idx"`
+	Name  types.String `tfsdk:"name"`
+}
+
+// bthomeSensorConfig mirrors the config object of BTHomeSensor.GetConfig /
+// BTHomeSensor.SetConfig. The address, object ID and index can only be set
+// when adding the sensor.
+type bthomeSensorConfig struct {
+	Addr  string  `json:"addr,omitempty"`
+	ObjID *int64  `json:"obj_id,omitempty"`
+	Idx   *int64  `json:"idx,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+type bthomeSensorResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *bthomeSensorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bthome_sensor"
+}
+
+func (c *bthomeSensorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *bthomeSensorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a sensor for one reading of a paired BTHome device, e.g. the window state of a Shelly BLU Door/Window. " +
+			"The sensor reports the reading as its status and emits events that scripts and webhooks can react to.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly gateway device.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": bthomeIDAttribute("BTHomeSensor"),
+			"addr": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The BLE MAC address of the BTHome device, e.g. `shelly_bthome_device.example.addr`. It is sent to the device in lowercase.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(bleAddressRegexp, "must be a MAC address such as aa:bb:cc:dd:ee:ff"),
+				},
+			},
+			"obj_id": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The BTHome object ID of the reading, e.g. `45` (0x2d) for window, `58` (0x3a) for button or `1` for battery.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 255),
+				},
+			},
+			"idx": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				MarkdownDescription: "Index of the reading among the readings with the same object ID, e.g. the button of a Shelly BLU RC Button 4. Defaults to 0.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 255),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the sensor.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func readBTHomeSensor(client *resty.Client, state *bthomeSensorResourceModel) error {
+	var config bthomeSensorConfig
+	if err := callRPC(client, "BTHomeSensor.GetConfig", map[string]any{"id": state.ID.ValueInt32()}, &config); err != nil {
+		return err
+	}
+	if !strings.EqualFold(config.Addr, state.Addr.ValueString()) {
+		state.Addr = types.StringValue(config.Addr)
+	}
+	state.ObjID = types.Int64PointerValue(config.ObjID)
+	state.Idx = types.Int64PointerValue(config.Idx)
+	state.Name = types.StringPointerValue(config.Name)
+	return nil
+}
+
+func (c *bthomeSensorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bthomeSensorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	err := readBTHomeSensor(client, &state)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query BTHomeSensor config", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *bthomeSensorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bthomeSensorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	config := bthomeSensorConfig{
+		Addr:  strings.ToLower(plan.Addr.ValueString()),
+		ObjID: int64Pointer(plan.ObjID),
+		Idx:   int64Pointer(plan.Idx),
+		Name:  stringPointer(plan.Name),
+	}
+	id, err := addBTHomeComponent(client, "BTHome.AddSensor", plan.ID, config)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add BTHome sensor", err.Error())
+		return
+	}
+	plan.ID = types.Int32Value(id)
+	if err := readBTHomeSensor(client, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query BTHomeSensor config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *bthomeSensorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan bthomeSensorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	params := map[string]any{
+		"id":     plan.ID.ValueInt32(),
+		"config": bthomeSensorConfig{Name: stringPointer(plan.Name)},
+	}
+	if err := callRPC(client, "BTHomeSensor.SetConfig", params, nil); err != nil {
+		resp.Diagnostics.AddError("Failed to set BTHomeSensor config", err.Error())
+		return
+	}
+	if err := readBTHomeSensor(client, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query BTHomeSensor config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *bthomeSensorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importDynamicComponentState(ctx, req, resp, "BTHomeSensor")
+}
+
+func (c *bthomeSensorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bthomeSensorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	// The sensor is already gone if its device was deleted first.
+	if err := callRPC(client, "BTHome.DeleteSensor", map[string]any{"id": state.ID.ValueInt32()}, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete BTHome sensor", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
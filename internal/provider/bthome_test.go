@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddBTHomeComponent(t *testing.T) {
+	var params map[string]any
+	client := newFakeDevice(t, func(method string, raw json.RawMessage) (any, *rpcError) {
+		params = nil
+		_ = json.Unmarshal(raw, &params)
+		return map[string]any{"key": "bthomesensor:203"}, nil
+	})
+
+	config := bthomeSensorConfig{Addr: "aa:bb:cc:dd:ee:ff", ObjID: new(int64), Idx: new(int64)}
+	id, err := addBTHomeComponent(client, "BTHome.AddSensor", types.Int32Unknown(), config)
+	require.NoError(t, err)
+	require.Equal(t, int32(203), id)
+	require.NotContains(t, params, "id")
+	require.Equal(t, map[string]any{"addr": "aa:bb:cc:dd:ee:ff", "obj_id": float64(0), "idx": float64(0)}, params["config"])
+
+	_, err = addBTHomeComponent(client, "BTHome.AddSensor", types.Int32Value(203), config)
+	require.NoError(t, err)
+	require.Equal(t, float64(203), params["id"])
+}
+
+func TestBLEAddressRegexp(t *testing.T) {
+	require.True(t, bleAddressRegexp.MatchString("aa:bb:cc:dd:ee:ff"))
+	require.True(t, bleAddressRegexp.MatchString("AA:BB:CC:dd:ee:ff"))
+	require.False(t, bleAddressRegexp.MatchString("aa:bb:cc:dd:ee:fg"))
+	require.False(t, bleAddressRegexp.MatchString("aabbccddeeff"))
+}
+
+func TestBTHomeDeviceCreateLowercasesAddress(t *testing.T) {
+	ctx := context.Background()
+	var added bthomeDeviceConfig
+	ip := fakeDeviceIP(t, func(method string, raw json.RawMessage) (any, *rpcError) {
+		switch method {
+		case "BTHome.AddDevice":
+			var params struct {
+				Config bthomeDeviceConfig `json:"config"`
+			}
+			_ = json.Unmarshal(raw, &params)
+			added = params.Config
+			return map[string]any{"key": "bthomedevice:200"}, nil
+		case "BTHomeDevice.GetConfig":
+			return map[string]any{"id": 200, "addr": added.Addr, "name": nil}, nil
+		}
+		return nil, &rpcError{Code: 404, Message: "No handler for " + method}
+	})
+
+	res := NewBTHomeDeviceResource()
+	config := resourceConfig(t, res, map[string]tftypes.Value{
+		"ip":   tftypes.NewValue(tftypes.String, ip),
+		"id":   tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		"addr": tftypes.NewValue(tftypes.String, "7C:C6:B6:00:00:01"),
+		"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: config.Schema, Raw: tftypes.NewValue(config.Raw.Type(), nil)}}
+	res.Create(ctx, resource.CreateRequest{Config: config, Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	require.Equal(t, "7c:c6:b6:00:00:01", added.Addr)
+
+	// The configured address is kept, so the plan does not change.
+	var state bthomeDeviceResourceModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	require.Equal(t, "7C:C6:B6:00:00:01", state.Addr.ValueString())
+	require.Equal(t, int32(200), state.ID.ValueInt32())
+}
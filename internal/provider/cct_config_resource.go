@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &cctConfigResource{}
+	_ resource.ResourceWithConfigure      = &cctConfigResource{}
+	_ resource.ResourceWithImportState    = &cctConfigResource{}
+	_ resource.ResourceWithValidateConfig = &cctConfigResource{}
+)
+
+func NewCCTConfigResource() resource.Resource {
+	return &cctConfigResource{}
+}
+
+type cctConfigResourceModel struct {
+	colorConfigResourceModel
+	CTRange types.List `tfsdk:"ct_range"`
+}
+
+// cctConfig mirrors the config object of CCT.GetConfig / CCT.SetConfig.
+type cctConfig struct {
+	colorConfig
+	CTRange []int64 `json:"ct_range,omitempty"`
+}
+
+type cctConfigResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *cctConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cct_config"
+}
+
+func (c *cctConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *cctConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := colorSchemaAttributes("CCT")
+	attributes["ct_range"] = schema.ListAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.Int64Type,
+		MarkdownDescription: "Minimum and maximum color temperature in Kelvin, e.g. `[2700, 6500]`.",
+		Validators: []validator.List{
+			listvalidator.SizeBetween(2, 2),
+			listvalidator.ValueInt64sAre(int64validator.Between(1000, 10000)),
+		},
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+	}
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (c *cctConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var list types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ct_range"), &list)...)
+	if resp.Diagnostics.HasError() || list.IsNull() || list.IsUnknown() {
+		return
+	}
+	var ctRange []types.Int64
+	resp.Diagnostics.Append(list.ElementsAs(ctx, &ctRange, false)...)
+	if resp.Diagnostics.HasError() || len(ctRange) != 2 || ctRange[0].IsUnknown() || ctRange[1].IsUnknown() {
+		return
+	}
+	if ctRange[0].ValueInt64() >= ctRange[1].ValueInt64() {
+		resp.Diagnostics.AddAttributeError(path.Root("ct_range"), "Invalid color temperature range",
+			"The minimum color temperature must be lower than the maximum.")
+	}
+}
+
+func readCCTConfig(ctx context.Context, credentials *deviceCredentials, state *cctConfigResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := newDeviceClient(credentials, state.IP.ValueString())
+	defer client.Close()
+
+	var config cctConfig
+	params := map[string]any{"id": state.ID.ValueInt32()}
+	if err := callRPC(client, "CCT.GetConfig", params, &config); err != nil {
+		diags.AddError("Failed to query CCT config", err.Error())
+		return diags
+	}
+
+	diags.Append(readColorConfig(ctx, &state.colorConfigResourceModel, &config.colorConfig)...)
+	if config.CTRange == nil {
+		state.CTRange = types.ListNull(types.Int64Type)
+	} else {
+		var d diag.Diagnostics
+		state.CTRange, d = types.ListValueFrom(ctx, types.Int64Type, config.CTRange)
+		diags.Append(d...)
+	}
+	return diags
+}
+
+func setCCTConfig(ctx context.Context, credentials *deviceCredentials, plan cctConfigResourceModel, diags *diag.Diagnostics) error {
+	config := cctConfig{
+		colorConfig: colorConfigFromPlan(ctx, &plan.colorConfigResourceModel, diags),
+	}
+	if !plan.CTRange.IsNull() && !plan.CTRange.IsUnknown() {
+		diags.Append(plan.CTRange.ElementsAs(ctx, &config.CTRange, false)...)
+	}
+	if diags.HasError() {
+		return errInvalidPlan
+	}
+
+	client := newDeviceClient(credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	params := map[string]any{"id": config.ID, "config": config}
+	if err := callRPC(client, "CCT.SetConfig", params, nil); err != nil {
+		diags.AddError("Failed to set CCT config", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (c *cctConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state cctConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(readCCTConfig(ctx, c.credentials, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *cctConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan cctConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setCCTConfig(ctx, c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	resp.Diagnostics.Append(readCCTConfig(ctx, c.credentials, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *cctConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan cctConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setCCTConfig(ctx, c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	resp.Diagnostics.Append(readCCTConfig(ctx, c.credentials, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *cctConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateIPAndID(ctx, req, resp, "id", "CCT")
+}
+
+func (c *cctConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCCTConfigValidateConfig(t *testing.T) {
+	listType := tftypes.List{ElementType: tftypes.Number}
+	for name, tc := range map[string]struct {
+		ctRange tftypes.Value
+		valid   bool
+	}{
+		"unset":           {tftypes.NewValue(listType, nil), true},
+		"unknown":         {tftypes.NewValue(listType, tftypes.UnknownValue), true},
+		"unknown element": {tftypes.NewValue(listType, []tftypes.Value{tftypes.NewValue(tftypes.Number, 2700), tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)}), true},
+		"ascending":       {tftypes.NewValue(listType, []tftypes.Value{tftypes.NewValue(tftypes.Number, 2700), tftypes.NewValue(tftypes.Number, 6500)}), true},
+		"descending":      {tftypes.NewValue(listType, []tftypes.Value{tftypes.NewValue(tftypes.Number, 6500), tftypes.NewValue(tftypes.Number, 2700)}), false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res := NewCCTConfigResource()
+			req := resource.ValidateConfigRequest{Config: resourceConfig(t, res, map[string]tftypes.Value{"ct_range": tc.ctRange})}
+			var resp resource.ValidateConfigResponse
+			res.(resource.ResourceWithValidateConfig).ValidateConfig(context.Background(), req, &resp)
+			require.Equal(t, !tc.valid, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
+
+func TestReadCCTConfigUnreportedNightMode(t *testing.T) {
+	ip := fakeDeviceIP(t, fakeResult(`{"id": 0, "name": "Ceiling", "ct_range": [2700, 6500]}`))
+
+	var state cctConfigResourceModel
+	state.IP = types.StringValue(ip)
+	state.ID = types.Int32Value(0)
+	state.NightMode = &nightModeModel{
+		Enable:        types.BoolUnknown(),
+		Brightness:    types.Float64Unknown(),
+		ActiveBetween: types.ListUnknown(types.StringType),
+	}
+	diags := readCCTConfig(context.Background(), nil, &state)
+	require.False(t, diags.HasError(), diags)
+
+	require.Equal(t, "Ceiling", state.Name.ValueString())
+	require.Len(t, state.CTRange.Elements(), 2)
+	require.True(t, state.NightMode.Enable.IsNull())
+	require.True(t, state.NightMode.Brightness.IsNull())
+	require.True(t, state.NightMode.ActiveBetween.IsNull())
+}
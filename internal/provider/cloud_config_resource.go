@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &cloudConfigResource{}
+	_ resource.ResourceWithConfigure   = &cloudConfigResource{}
+	_ resource.ResourceWithImportState = &cloudConfigResource{}
+)
+
+func NewCloudConfigResource() resource.Resource {
+	return &cloudConfigResource{}
+}
+
+type cloudConfigResourceModel struct {
+	IP        types.String `tfsdk:"ip"`
+	Enable    types.Bool   `tfsdk:"enable"`
+	Server    types.String `tfsdk:"server"`
+	Connected types.Bool   `tfsdk:"connected"`
+	Reboot    types.Bool   `tfsdk:"reboot"`
+}
+
+// cloudConfig mirrors the config object of Cloud.GetConfig / Cloud.SetConfig.
+type cloudConfig struct {
+	Enable *bool   `json:"enable,omitempty"`
+	Server *string `json:"server,omitempty"`
+}
+
+type cloudStatus struct {
+	Connected bool `json:"connected"`
+}
+
+type cloudConfigResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *cloudConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_config"
+}
+
+func (c *cloudConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *cloudConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+			},
+			"enable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if the Shelly Cloud connection is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the Shelly Cloud server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connected": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "True if the device is connected to Shelly Cloud.",
+			},
+			"reboot": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Reboot the device when the change requires it, so that it takes effect right away. Defaults to true.",
+			},
+		},
+	}
+}
+
+func readCloudConfig(credentials *deviceCredentials, state *cloudConfigResourceModel) error {
+	client := newDeviceClient(credentials, state.IP.ValueString())
+	defer client.Close()
+
+	var config cloudConfig
+	if err := callRPC(client, "Cloud.GetConfig", nil, &config); err != nil {
+		return err
+	}
+	var status cloudStatus
+	if err := callRPC(client, "Cloud.GetStatus", nil, &status); err != nil {
+		return err
+	}
+	state.Enable = types.BoolPointerValue(config.Enable)
+	state.Server = types.StringPointerValue(config.Server)
+	state.Connected = types.BoolValue(status.Connected)
+	return nil
+}
+
+func (c *cloudConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state cloudConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := readCloudConfig(c.credentials, &state); err != nil {
+		resp.Diagnostics.AddError("Failed to query cloud config", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func setCloudConfig(ctx context.Context, credentials *deviceCredentials, plan cloudConfigResourceModel, diags *diag.Diagnostics) error {
+	cloud := cloudConfig{
+		Enable: boolPointer(plan.Enable),
+		Server: stringPointer(plan.Server),
+	}
+
+	client := newDeviceClient(credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	var result setConfigResult
+	if err := callRPC(client, "Cloud.SetConfig", map[string]any{"config": cloud}, &result); err != nil {
+		diags.AddError("Failed to set cloud config", err.Error())
+		return err
+	}
+
+	if !result.RestartRequired {
+		return nil
+	}
+	if !plan.Reboot.ValueBool() {
+		diags.AddWarning("Reboot required", "The cloud config takes effect after the device is rebooted.")
+		return nil
+	}
+	if err := rebootDevice(ctx, credentials, plan.IP.ValueString()); err != nil {
+		diags.AddError("Failed to reboot device", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (c *cloudConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan cloudConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setCloudConfig(ctx, c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readCloudConfig(c.credentials, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query cloud config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *cloudConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan cloudConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setCloudConfig(ctx, c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readCloudConfig(c.credentials, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query cloud config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *cloudConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ip"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reboot"), true)...)
+}
+
+func (c *cloudConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
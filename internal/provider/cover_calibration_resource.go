@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"resty.dev/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &coverCalibrationResource{}
+	_ resource.ResourceWithConfigure = &coverCalibrationResource{}
+)
+
+func NewCoverCalibrationResource() resource.Resource {
+	return &coverCalibrationResource{}
+}
+
+type coverCalibrationResourceModel struct {
+	IP       types.String `tfsdk:"ip"`
+	ID       types.Int32  `tfsdk:"id"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	Timeout  types.Int64  `tfsdk:"timeout"`
+}
+
+// coverStatus is the subset of Cover.GetStatus needed to follow a calibration.
+type coverStatus struct {
+	State      string   `json:"state"`
+	PosControl bool     `json:"pos_control"`
+	Errors     []string `json:"errors"`
+}
+
+type coverCalibrationResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *coverCalibrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cover_calibration"
+}
+
+func (c *coverCalibrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *coverCalibrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Calibrates a cover and waits for the calibration to finish. The calibration runs when the resource is created and again whenever `triggers` change.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.Int32Attribute{
+				Required:            true,
+				MarkdownDescription: "The zero-based ID of the cover to calibrate (e.g., 0 for the first cover).",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary map of values that, when changed, re-run the calibration.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+				MarkdownDescription: "How long to wait (in seconds) for the calibration to finish. Defaults to 300.",
+			},
+		},
+	}
+}
+
+func (c *coverCalibrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The calibration is an operation, not a setting, so there is nothing to
+	// refresh.
+	var state coverCalibrationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func getCoverStatus(client *resty.Client, id int32) (*coverStatus, error) {
+	var status coverStatus
+	if err := callRPC(client, "Cover.GetStatus", map[string]any{"id": id}, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// calibrateCover starts the calibration of cover id and polls its status
+// until the calibration is over.
+func calibrateCover(ctx context.Context, credentials *deviceCredentials, ip string, id int32, timeout time.Duration) error {
+	client := newDeviceClient(credentials, ip)
+	defer client.Close()
+
+	if err := callRPC(client, "Cover.Calibrate", map[string]any{"id": id}, nil); err != nil {
+		return err
+	}
+
+	started := time.Now()
+	deadline := started.Add(timeout)
+	seenCalibrating := false
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(devicePollInterval):
+		}
+
+		status, err := getCoverStatus(client, id)
+		if err != nil {
+			return err
+		}
+		if status.State == "calibrating" {
+			seenCalibrating = true
+		} else if seenCalibrating || time.Since(started) > devicePollInterval*5 {
+			if !status.PosControl {
+				reason := "no error reported"
+				if len(status.Errors) > 0 {
+					reason = strings.Join(status.Errors, ", ")
+				}
+				return fmt.Errorf("calibration did not complete (state %s): %s", status.State, reason)
+			}
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("calibration did not finish within %s", timeout)
+		}
+	}
+}
+
+func (c *coverCalibrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan coverCalibrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := time.Duration(plan.Timeout.ValueInt64()) * time.Second
+	if err := calibrateCover(ctx, c.credentials, plan.IP.ValueString(), plan.ID.ValueInt32(), timeout); err != nil {
+		resp.Diagnostics.AddError("Cover calibration failed", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *coverCalibrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only timeout can change without replacement, so there is nothing to apply.
+	var plan coverCalibrationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *coverCalibrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,441 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &coverConfigResource{}
+	_ resource.ResourceWithConfigure   = &coverConfigResource{}
+	_ resource.ResourceWithImportState = &coverConfigResource{}
+)
+
+func NewCoverConfigResource() resource.Resource {
+	return &coverConfigResource{}
+}
+
+type coverConfigResourceModel struct {
+	IP                   types.String              `tfsdk:"ip"`
+	ID                   types.Int32               `tfsdk:"id"`
+	Name                 types.String              `tfsdk:"name"`
+	InMode               types.String              `tfsdk:"in_mode"`
+	InitialState         types.String              `tfsdk:"initial_state"`
+	InvertDirections     types.Bool                `tfsdk:"invert_directions"`
+	SwapInputs           types.Bool                `tfsdk:"swap_inputs"`
+	PowerLimit           types.Float64             `tfsdk:"power_limit"`
+	VoltageLimit         types.Float64             `tfsdk:"voltage_limit"`
+	UndervoltageLimit    types.Float64             `tfsdk:"undervoltage_limit"`
+	CurrentLimit         types.Float64             `tfsdk:"current_limit"`
+	MaxtimeOpen          types.Float64             `tfsdk:"maxtime_open"`
+	MaxtimeClose         types.Float64             `tfsdk:"maxtime_close"`
+	Motor                *coverMotorModel          `tfsdk:"motor"`
+	ObstructionDetection *coverObstructionDetModel `tfsdk:"obstruction_detection"`
+	SafetySwitch         *coverSafetySwitchModel   `tfsdk:"safety_switch"`
+}
+
+type coverMotorModel struct {
+	IdlePowerThr      types.Float64 `tfsdk:"idle_power_thr"`
+	IdleConfirmPeriod types.Float64 `tfsdk:"idle_confirm_period"`
+}
+
+type coverObstructionDetModel struct {
+	Enable    types.Bool    `tfsdk:"enable"`
+	Direction types.String  `tfsdk:"direction"`
+	Action    types.String  `tfsdk:"action"`
+	PowerThr  types.Float64 `tfsdk:"power_thr"`
+	Holdoff   types.Float64 `tfsdk:"holdoff"`
+}
+
+type coverSafetySwitchModel struct {
+	Enable      types.Bool   `tfsdk:"enable"`
+	Direction   types.String `tfsdk:"direction"`
+	Action      types.String `tfsdk:"action"`
+	AllowedMove types.String `tfsdk:"allowed_move"`
+}
+
+// coverConfig mirrors the config object of Cover.GetConfig / Cover.SetConfig.
+type coverConfig struct {
+	ID                   int                        `json:"id"`
+	Name                 *string                    `json:"name,omitempty"`
+	InMode               *string                    `json:"in_mode,omitempty"`
+	InitialState         *string                    `json:"initial_state,omitempty"`
+	InvertDirections     *bool                      `json:"invert_directions,omitempty"`
+	SwapInputs           *bool                      `json:"swap_inputs,omitempty"`
+	PowerLimit           *float64                   `json:"power_limit,omitempty"`
+	VoltageLimit         *float64                   `json:"voltage_limit,omitempty"`
+	UndervoltageLimit    *float64                   `json:"undervoltage_limit,omitempty"`
+	CurrentLimit         *float64                   `json:"current_limit,omitempty"`
+	MaxtimeOpen          *float64                   `json:"maxtime_open,omitempty"`
+	MaxtimeClose         *float64                   `json:"maxtime_close,omitempty"`
+	Motor                *coverMotorConfig          `json:"motor,omitempty"`
+	ObstructionDetection *coverObstructionDetConfig `json:"obstruction_detection,omitempty"`
+	SafetySwitch         *coverSafetySwitchConfig   `json:"safety_switch,omitempty"`
+}
+
+type coverMotorConfig struct {
+	IdlePowerThr      *float64 `json:"idle_power_thr,omitempty"`
+	IdleConfirmPeriod *float64 `json:"idle_confirm_period,omitempty"`
+}
+
+type coverObstructionDetConfig struct {
+	Enable    *bool    `json:"enable,omitempty"`
+	Direction *string  `json:"direction,omitempty"`
+	Action    *string  `json:"action,omitempty"`
+	PowerThr  *float64 `json:"power_thr,omitempty"`
+	Holdoff   *float64 `json:"holdoff,omitempty"`
+}
+
+type coverSafetySwitchConfig struct {
+	Enable      *bool   `json:"enable,omitempty"`
+	Direction   *string `json:"direction,omitempty"`
+	Action      *string `json:"action,omitempty"`
+	AllowedMove *string `json:"allowed_move"`
+}
+
+type coverConfigResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *coverConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cover_config"
+}
+
+func (c *coverConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *coverConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+			},
+			"id": schema.Int32Attribute{
+				Required:            true,
+				MarkdownDescription: "The zero-based ID of the cover to configure (e.g., 0 for the first cover).",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the cover instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"in_mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Mode of the associated inputs. Range of values: single, dual, detached.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("single", "dual", "detached"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"initial_state": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Cover state to set on power on. Range of values: open, closed, stopped.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("open", "closed", "stopped"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invert_directions": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if the open and close directions are swapped.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"swap_inputs": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if the functions of the two inputs are swapped.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"power_limit":        optionalFloat64Attribute("Watts, limit that must be exceeded to trigger an overpower error."),
+			"voltage_limit":      optionalFloat64Attribute("Volts, limit that must be exceeded to trigger an overvoltage error."),
+			"undervoltage_limit": optionalFloat64Attribute("Volts, limit that must be undercut to trigger an undervoltage error."),
+			"current_limit":      optionalFloat64Attribute("Amperes, limit that must be exceeded to trigger an overcurrent error."),
+			"maxtime_open":       optionalFloat64Attribute("Seconds, default timeout after which the cover stops moving in the open direction."),
+			"maxtime_close":      optionalFloat64Attribute("Seconds, default timeout after which the cover stops moving in the close direction."),
+			"motor": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Motor idle detection.",
+				Attributes: map[string]schema.Attribute{
+					"idle_power_thr":      optionalFloat64Attribute("Watts, power below which the motor is considered idle."),
+					"idle_confirm_period": optionalFloat64Attribute("Seconds, how long the power has to stay below `idle_power_thr` before the motor is considered idle."),
+				},
+			},
+			"obstruction_detection": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Obstruction detection settings.",
+				Attributes: map[string]schema.Attribute{
+					"enable": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "True if obstruction detection is enabled.",
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"direction": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Direction of movement in which obstructions are detected. Range of values: open, close, both.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("open", "close", "both"),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"action": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Action to take when an obstruction is detected. Range of values: stop, reverse.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("stop", "reverse"),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"power_thr": optionalFloat64Attribute("Watts, power above which an obstruction is detected."),
+					"holdoff":   optionalFloat64Attribute("Seconds to wait after the motor starts before checking for obstructions."),
+				},
+			},
+			"safety_switch": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Safety switch settings (only if the device has one).",
+				Attributes: map[string]schema.Attribute{
+					"enable": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "True if the safety switch is enabled.",
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"direction": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Direction of movement in which the safety switch is honored. Range of values: open, close, both.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("open", "close", "both"),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"action": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Action to take when the safety switch is triggered. Range of values: stop, reverse, pause.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("stop", "reverse", "pause"),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"allowed_move": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Movement allowed while the safety switch is engaged. Only `reverse` is supported; unset means no movement.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("reverse"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readCoverConfig(credentials *deviceCredentials, state *coverConfigResourceModel) error {
+	client := newDeviceClient(credentials, state.IP.ValueString())
+	defer client.Close()
+
+	var config coverConfig
+	params := map[string]any{"id": state.ID.ValueInt32()}
+	if err := callRPC(client, "Cover.GetConfig", params, &config); err != nil {
+		return err
+	}
+
+	state.Name = types.StringPointerValue(config.Name)
+	state.InMode = types.StringPointerValue(config.InMode)
+	state.InitialState = types.StringPointerValue(config.InitialState)
+	state.InvertDirections = types.BoolPointerValue(config.InvertDirections)
+	state.SwapInputs = types.BoolPointerValue(config.SwapInputs)
+	state.PowerLimit = types.Float64PointerValue(config.PowerLimit)
+	state.VoltageLimit = types.Float64PointerValue(config.VoltageLimit)
+	state.UndervoltageLimit = types.Float64PointerValue(config.UndervoltageLimit)
+	state.CurrentLimit = types.Float64PointerValue(config.CurrentLimit)
+	state.MaxtimeOpen = types.Float64PointerValue(config.MaxtimeOpen)
+	state.MaxtimeClose = types.Float64PointerValue(config.MaxtimeClose)
+	// Nested settings are only refreshed if they are managed. Sections the
+	// device does not report leave their settings null.
+	if state.Motor != nil {
+		motor := config.Motor
+		if motor == nil {
+			motor = &coverMotorConfig{}
+		}
+		state.Motor.IdlePowerThr = types.Float64PointerValue(motor.IdlePowerThr)
+		state.Motor.IdleConfirmPeriod = types.Float64PointerValue(motor.IdleConfirmPeriod)
+	}
+	if state.ObstructionDetection != nil {
+		obstruction := config.ObstructionDetection
+		if obstruction == nil {
+			obstruction = &coverObstructionDetConfig{}
+		}
+		state.ObstructionDetection.Enable = types.BoolPointerValue(obstruction.Enable)
+		state.ObstructionDetection.Direction = types.StringPointerValue(obstruction.Direction)
+		state.ObstructionDetection.Action = types.StringPointerValue(obstruction.Action)
+		state.ObstructionDetection.PowerThr = types.Float64PointerValue(obstruction.PowerThr)
+		state.ObstructionDetection.Holdoff = types.Float64PointerValue(obstruction.Holdoff)
+	}
+	if state.SafetySwitch != nil {
+		safety := config.SafetySwitch
+		if safety == nil {
+			safety = &coverSafetySwitchConfig{}
+		}
+		state.SafetySwitch.Enable = types.BoolPointerValue(safety.Enable)
+		state.SafetySwitch.Direction = types.StringPointerValue(safety.Direction)
+		state.SafetySwitch.Action = types.StringPointerValue(safety.Action)
+		state.SafetySwitch.AllowedMove = types.StringPointerValue(safety.AllowedMove)
+	}
+	return nil
+}
+
+func (c *coverConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coverConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := readCoverConfig(c.credentials, &state); err != nil {
+		resp.Diagnostics.AddError("Failed to query cover config", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func setCoverConfig(credentials *deviceCredentials, plan coverConfigResourceModel, diags *diag.Diagnostics) error {
+	cover := coverConfig{
+		ID:                int(plan.ID.ValueInt32()),
+		Name:              stringPointer(plan.Name),
+		InMode:            stringPointer(plan.InMode),
+		InitialState:      stringPointer(plan.InitialState),
+		InvertDirections:  boolPointer(plan.InvertDirections),
+		SwapInputs:        boolPointer(plan.SwapInputs),
+		PowerLimit:        float64Pointer(plan.PowerLimit),
+		VoltageLimit:      float64Pointer(plan.VoltageLimit),
+		UndervoltageLimit: float64Pointer(plan.UndervoltageLimit),
+		CurrentLimit:      float64Pointer(plan.CurrentLimit),
+		MaxtimeOpen:       float64Pointer(plan.MaxtimeOpen),
+		MaxtimeClose:      float64Pointer(plan.MaxtimeClose),
+	}
+	if plan.Motor != nil {
+		cover.Motor = &coverMotorConfig{
+			IdlePowerThr:      float64Pointer(plan.Motor.IdlePowerThr),
+			IdleConfirmPeriod: float64Pointer(plan.Motor.IdleConfirmPeriod),
+		}
+	}
+	if plan.ObstructionDetection != nil {
+		cover.ObstructionDetection = &coverObstructionDetConfig{
+			Enable:    boolPointer(plan.ObstructionDetection.Enable),
+			Direction: stringPointer(plan.ObstructionDetection.Direction),
+			Action:    stringPointer(plan.ObstructionDetection.Action),
+			PowerThr:  float64Pointer(plan.ObstructionDetection.PowerThr),
+			Holdoff:   float64Pointer(plan.ObstructionDetection.Holdoff),
+		}
+	}
+	if plan.SafetySwitch != nil {
+		cover.SafetySwitch = &coverSafetySwitchConfig{
+			Enable:      boolPointer(plan.SafetySwitch.Enable),
+			Direction:   stringPointer(plan.SafetySwitch.Direction),
+			Action:      stringPointer(plan.SafetySwitch.Action),
+			AllowedMove: stringPointer(plan.SafetySwitch.AllowedMove),
+		}
+	}
+
+	client := newDeviceClient(credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	params := map[string]any{"id": cover.ID, "config": cover}
+	if err := callRPC(client, "Cover.SetConfig", params, nil); err != nil {
+		diags.AddError("Failed to set cover config", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (c *coverConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan coverConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setCoverConfig(c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readCoverConfig(c.credentials, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query cover config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *coverConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coverConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setCoverConfig(c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readCoverConfig(c.credentials, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query cover config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *coverConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateIPAndID(ctx, req, resp, "id", "cover")
+}
+
+func (c *coverConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
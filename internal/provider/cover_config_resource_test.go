@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoverConfigNestedSettings(t *testing.T) {
+	var sent map[string]any
+	client := newFakeDevice(t, func(method string, raw json.RawMessage) (any, *rpcError) {
+		if method == "Cover.SetConfig" {
+			var params struct {
+				Config map[string]any `json:"config"`
+			}
+			_ = json.Unmarshal(raw, &params)
+			sent = params.Config
+			return nil, nil
+		}
+		// The device has no motor section.
+		return json.RawMessage(`{"id": 0, "name": "Blinds", "safety_switch": {"enable": true, "direction": "both", "action": "stop", "allowed_move": null}}`), nil
+	})
+
+	plan := coverConfigResourceModel{
+		IP:    types.StringValue(strings.TrimPrefix(client.BaseURL(), "http://")),
+		ID:    types.Int32Value(0),
+		Motor: &coverMotorModel{IdlePowerThr: types.Float64Unknown(), IdleConfirmPeriod: types.Float64Unknown()},
+		SafetySwitch: &coverSafetySwitchModel{
+			Enable:      types.BoolValue(true),
+			Direction:   types.StringUnknown(),
+			Action:      types.StringUnknown(),
+			AllowedMove: types.StringNull(),
+		},
+	}
+
+	// Removing allowed_move sends null to clear it.
+	var diags diag.Diagnostics
+	require.NoError(t, setCoverConfig(nil, plan, &diags))
+	safety, ok := sent["safety_switch"].(map[string]any)
+	require.True(t, ok)
+	require.Contains(t, safety, "allowed_move")
+	require.Nil(t, safety["allowed_move"])
+
+	require.NoError(t, readCoverConfig(nil, &plan))
+	require.True(t, plan.Motor.IdlePowerThr.IsNull())
+	require.True(t, plan.Motor.IdleConfirmPeriod.IsNull())
+	require.Equal(t, "both", plan.SafetySwitch.Direction.ValueString())
+	require.True(t, plan.SafetySwitch.AllowedMove.IsNull())
+	require.Nil(t, plan.ObstructionDetection)
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"resty.dev/v3"
+)
+
+// authUser is the only user Gen2 devices support.
+const authUser = "admin"
+
+// deviceCredentials holds the passwords used to reach devices. The provider
+// passes it to its resources and data sources as provider data.
+type deviceCredentials struct {
+	mu sync.RWMutex
+	// defaultPassword is the password configured on the provider. It is
+	// used for every device without a password of its own.
+	defaultPassword string
+	// passwords holds the passwords set by shelly_auth, keyed by IP, so
+	// that resources applied after it reach the device with them.
+	passwords map[string]string
+}
+
+func newDeviceCredentials(defaultPassword string) *deviceCredentials {
+	return &deviceCredentials{
+		defaultPassword: defaultPassword,
+		passwords:       map[string]string{},
+	}
+}
+
+// providerCredentials returns the credentials passed as provider data. It
+// returns nil before the provider is configured.
+func providerCredentials(providerData any, diags *diag.Diagnostics) *deviceCredentials {
+	if providerData == nil {
+		return nil
+	}
+	credentials, ok := providerData.(*deviceCredentials)
+	if !ok {
+		diags.AddError("Unexpected provider data",
+			fmt.Sprintf("Expected *deviceCredentials, got %T. Please report this issue to the provider developers.", providerData))
+		return nil
+	}
+	return credentials
+}
+
+// setPassword records the password of the device at ip. An empty password
+// falls back to the provider password.
+func (c *deviceCredentials) setPassword(ip, password string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if password == "" {
+		delete(c.passwords, ip)
+		return
+	}
+	c.passwords[ip] = password
+}
+
+// password returns the password to authenticate to the device at ip with,
+// or "" if none is known. A nil c knows no passwords.
+func (c *deviceCredentials) password(ip string) string {
+	if c == nil {
+		return ""
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if password, ok := c.passwords[ip]; ok {
+		return password
+	}
+	return c.defaultPassword
+}
+
+// apply sets up client to answer the digest challenge of the device at ip.
+// Devices without authentication never send one, so a known password does
+// no harm if it is not needed.
+func (c *deviceCredentials) apply(client *resty.Client, ip string) {
+	if password := c.password(ip); password != "" {
+		client.SetTransport(&digestTransport{
+			base:     client.Transport(),
+			user:     authUser,
+			password: password,
+		})
+	}
+}
+
+// digestTransport answers the SHA-256 digest challenge of a device. Unlike
+// resty's digest auth, which probes every request without its body first,
+// it sends the request as is and only repeats it after a 401, so that
+// devices without authentication still get the request.
+type digestTransport struct {
+	base     http.RoundTripper
+	user     string
+	password string
+}
+
+func (t *digestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	first := req.Clone(req.Context())
+	first.Body = io.NopCloser(bytes.NewReader(body))
+	resp, err := t.base.RoundTrip(first)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	challenge := parseDigestChallenge(resp.Header.Get("WWW-Authenticate"))
+	if challenge == nil {
+		return resp, nil
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	second := req.Clone(req.Context())
+	second.Body = io.NopCloser(bytes.NewReader(body))
+	authorization, err := digestAuthorization(t.user, t.password, req.Method, req.URL.RequestURI(), challenge)
+	if err != nil {
+		return nil, err
+	}
+	second.Header.Set("Authorization", authorization)
+	return t.base.RoundTrip(second)
+}
+
+var digestParamRe = regexp.MustCompile(`(\w+)=(?:"([^"]*)"|([^\s,]*))`)
+
+// parseDigestChallenge returns the parameters of a WWW-Authenticate digest
+// challenge, or nil if header is not one.
+func parseDigestChallenge(header string) map[string]string {
+	rest, ok := strings.CutPrefix(header, "Digest ")
+	if !ok {
+		return nil
+	}
+	params := map[string]string{}
+	for _, m := range digestParamRe.FindAllStringSubmatch(rest, -1) {
+		params[m[1]] = m[2] + m[3]
+	}
+	return params
+}
+
+// digestAuthorization returns the Authorization header answering challenge
+// for a request of method to uri. Devices only offer SHA-256 with qop auth.
+func digestAuthorization(user, password, method, uri string, challenge map[string]string) (string, error) {
+	cnonceBytes := make([]byte, 8)
+	if _, err := rand.Read(cnonceBytes); err != nil {
+		return "", err
+	}
+	cnonce := hex.EncodeToString(cnonceBytes)
+	const nc = "00000001"
+
+	realm, nonce := challenge["realm"], challenge["nonce"]
+	ha1 := authHA1(user, realm, password)
+	ha2 := sha256Hex(method + ":" + uri)
+	response := sha256Hex(ha1 + ":" + nonce + ":" + nc + ":" + cnonce + ":auth:" + ha2)
+
+	authorization := fmt.Sprintf(`Digest username="%s", realm="%s", nonce="%s", uri="%s", algorithm=SHA-256, response="%s", qop=auth, nc=%s, cnonce="%s"`,
+		user, realm, nonce, uri, response, nc, cnonce)
+	if opaque, ok := challenge["opaque"]; ok {
+		authorization += fmt.Sprintf(`, opaque="%s"`, opaque)
+	}
+	return authorization, nil
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// authHA1 returns the ha1 parameter of Shelly.SetAuth, the hex-encoded
+// SHA-256 of user:realm:password.
+func authHA1(user, realm, password string) string {
+	return sha256Hex(user + ":" + realm + ":" + password)
+}
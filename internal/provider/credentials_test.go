@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthHA1(t *testing.T) {
+	require.Equal(t, "5e98ca953e4446620f58647790c87480fbec8775e6150a3850b2a5448c152b27",
+		authHA1("admin", "shellyplus1pm-a8032ab12345", "secret"))
+}
+
+func TestDevicePassword(t *testing.T) {
+	var unconfigured *deviceCredentials
+	require.Empty(t, unconfigured.password("10.0.0.1"))
+
+	credentials := newDeviceCredentials("")
+	require.Empty(t, credentials.password("10.0.0.1"))
+
+	credentials = newDeviceCredentials("default")
+	require.Equal(t, "default", credentials.password("10.0.0.1"))
+
+	credentials.setPassword("10.0.0.1", "device")
+	require.Equal(t, "device", credentials.password("10.0.0.1"))
+	require.Equal(t, "default", credentials.password("10.0.0.2"))
+
+	credentials.setPassword("10.0.0.1", "")
+	require.Equal(t, "default", credentials.password("10.0.0.1"))
+}
+
+func TestNewDeviceClientDigestAuth(t *testing.T) {
+	const realm, nonce = "shellyplus1pm-a8032ab12345", "60dc59c6"
+	var authorization map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = parseDigestChallenge(r.Header.Get("Authorization"))
+		if authorization == nil {
+			w.Header().Set("WWW-Authenticate", `Digest qop="auth", realm="`+realm+`", nonce="`+nonce+`", algorithm=SHA-256`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"method":"Shelly.GetConfig"`) {
+			http.Error(w, "missing body", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1, "result": {}}`))
+	}))
+	defer server.Close()
+
+	ip := strings.TrimPrefix(server.URL, "http://")
+	credentials := newDeviceCredentials("")
+
+	client := newDeviceClient(credentials, ip)
+	require.Error(t, callRPC(client, "Shelly.GetConfig", nil, nil))
+	client.Close()
+
+	credentials.setPassword(ip, "secret")
+	client = newDeviceClient(credentials, ip)
+	defer client.Close()
+	require.NoError(t, callRPC(client, "Shelly.GetConfig", nil, nil))
+	require.Equal(t, "admin", authorization["username"])
+	require.Equal(t, realm, authorization["realm"])
+	ha2 := sha256Hex("POST:/rpc")
+	require.Equal(t, sha256Hex(authHA1("admin", realm, "secret")+":"+nonce+":"+authorization["nc"]+":"+authorization["cnonce"]+":auth:"+ha2),
+		authorization["response"])
+}
+
+// A device without authentication never challenges the client, so a known
+// password must not keep the request from reaching it.
+func TestNewDeviceClientWithoutAuth(t *testing.T) {
+	ip := fakeDeviceIP(t, fakeResult(`{"id": "shellyplus1pm-a8032ab12345"}`))
+	client := newDeviceClient(newDeviceCredentials("secret"), ip)
+	defer client.Close()
+
+	info, err := getDeviceInfo(client)
+	require.NoError(t, err)
+	require.Equal(t, "shellyplus1pm-a8032ab12345", info.ID)
+}
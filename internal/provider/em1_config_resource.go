@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &em1ConfigResource{}
+	_ resource.ResourceWithConfigure   = &em1ConfigResource{}
+	_ resource.ResourceWithImportState = &em1ConfigResource{}
+	_ resource.ResourceWithModifyPlan  = &em1ConfigResource{}
+)
+
+func NewEM1ConfigResource() resource.Resource {
+	return &em1ConfigResource{}
+}
+
+type em1ConfigResourceModel struct {
+	IP      types.String `tfsdk:"ip"`
+	ID      types.Int32  `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	CTType  types.String `tfsdk:"ct_type"`
+	Reverse types.Bool   `tfsdk:"reverse"`
+	Reboot  types.Bool   `tfsdk:"reboot"`
+}
+
+// em1Config mirrors the config object of EM1.GetConfig / EM1.SetConfig.
+type em1Config struct {
+	ID      int     `json:"id"`
+	Name    *string `json:"name,omitempty"`
+	CTType  *string `json:"ct_type,omitempty"`
+	Reverse *bool   `json:"reverse,omitempty"`
+}
+
+type em1ConfigResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *em1ConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_em1_config"
+}
+
+func (c *em1ConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *em1ConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+			},
+			"id": schema.Int32Attribute{
+				Required:            true,
+				MarkdownDescription: "The zero-based ID of the EM1 component to configure (e.g., 0 for the first one).",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the EM1 instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ct_type": ctTypeSchemaAttribute(),
+			"reverse": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if the measured current direction is reversed.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reboot": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Reboot the device when the change requires it, so that it takes effect right away. Defaults to true.",
+			},
+		},
+	}
+}
+
+func (c *em1ConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateCTTypeForModel(ctx, c.credentials, req, &resp.Diagnostics)
+}
+
+func readEM1Config(credentials *deviceCredentials, state *em1ConfigResourceModel) error {
+	client := newDeviceClient(credentials, state.IP.ValueString())
+	defer client.Close()
+
+	var config em1Config
+	params := map[string]any{"id": state.ID.ValueInt32()}
+	if err := callRPC(client, "EM1.GetConfig", params, &config); err != nil {
+		return err
+	}
+
+	state.Name = types.StringPointerValue(config.Name)
+	state.CTType = types.StringPointerValue(config.CTType)
+	state.Reverse = types.BoolPointerValue(config.Reverse)
+	return nil
+}
+
+func (c *em1ConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state em1ConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := readEM1Config(c.credentials, &state); err != nil {
+		resp.Diagnostics.AddError("Failed to query EM1 config", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func setEM1Config(ctx context.Context, credentials *deviceCredentials, plan em1ConfigResourceModel, diags *diag.Diagnostics) error {
+	em1 := em1Config{
+		ID:      int(plan.ID.ValueInt32()),
+		Name:    stringPointer(plan.Name),
+		CTType:  stringPointer(plan.CTType),
+		Reverse: boolPointer(plan.Reverse),
+	}
+
+	client := newDeviceClient(credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	var result setConfigResult
+	params := map[string]any{"id": em1.ID, "config": em1}
+	if err := callRPC(client, "EM1.SetConfig", params, &result); err != nil {
+		diags.AddError("Failed to set EM1 config", err.Error())
+		return err
+	}
+
+	if !result.RestartRequired {
+		return nil
+	}
+	if !plan.Reboot.ValueBool() {
+		diags.AddWarning("Reboot required", "The EM1 config takes effect after the device is rebooted.")
+		return nil
+	}
+	if err := rebootDevice(ctx, credentials, plan.IP.ValueString()); err != nil {
+		diags.AddError("Failed to reboot device", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (c *em1ConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan em1ConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setEM1Config(ctx, c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readEM1Config(c.credentials, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query EM1 config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *em1ConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan em1ConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setEM1Config(ctx, c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readEM1Config(c.credentials, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query EM1 config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *em1ConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateIPAndID(ctx, req, resp, "id", "EM1")
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reboot"), true)...)
+}
+
+func (c *em1ConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,356 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &emConfigResource{}
+	_ resource.ResourceWithConfigure   = &emConfigResource{}
+	_ resource.ResourceWithImportState = &emConfigResource{}
+	_ resource.ResourceWithModifyPlan  = &emConfigResource{}
+)
+
+// ctTypes are all current transformer types known to the EM and EM1 components.
+var ctTypes = []string{"50A", "63A", "120A", "400A"}
+
+// ctTypesByModel lists the current transformer types supported by each
+// energy meter model. Models not listed here are not checked.
+var ctTypesByModel = map[string][]string{
+	"SPEM-003CEBEU":    {"120A"},
+	"SPEM-003CEBEU120": {"120A"},
+	"SPEM-003CEBEU63":  {"63A"},
+	"SPEM-003CEBEU400": {"400A"},
+	"SPEM-002CEBEU50":  {"50A"},
+	"S3EM-002CXCEU":    {"50A"},
+}
+
+func NewEMConfigResource() resource.Resource {
+	return &emConfigResource{}
+}
+
+type emConfigResourceModel struct {
+	IP                   types.String    `tfsdk:"ip"`
+	ID                   types.Int32     `tfsdk:"id"`
+	Name                 types.String    `tfsdk:"name"`
+	CTType               types.String    `tfsdk:"ct_type"`
+	MonitorPhaseSequence types.Bool      `tfsdk:"monitor_phase_sequence"`
+	PhaseSelector        types.String    `tfsdk:"phase_selector"`
+	Reverse              *emReverseModel `tfsdk:"reverse"`
+	Reboot               types.Bool      `tfsdk:"reboot"`
+}
+
+type emReverseModel struct {
+	A types.Bool `tfsdk:"a"`
+	B types.Bool `tfsdk:"b"`
+	C types.Bool `tfsdk:"c"`
+}
+
+// emConfig mirrors the config object of EM.GetConfig / EM.SetConfig.
+type emConfig struct {
+	ID                   int              `json:"id"`
+	Name                 *string          `json:"name,omitempty"`
+	CTType               *string          `json:"ct_type,omitempty"`
+	MonitorPhaseSequence *bool            `json:"monitor_phase_sequence,omitempty"`
+	PhaseSelector        *string          `json:"phase_selector,omitempty"`
+	Reverse              *emReverseConfig `json:"reverse,omitempty"`
+}
+
+type emReverseConfig struct {
+	A *bool `json:"a,omitempty"`
+	B *bool `json:"b,omitempty"`
+	C *bool `json:"c,omitempty"`
+}
+
+type emConfigResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *emConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_em_config"
+}
+
+func (c *emConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+// ctTypeSchemaAttribute is shared by the EM and EM1 components.
+func ctTypeSchemaAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		MarkdownDescription: "Type of the current transformers. Range of values: " + strings.Join(ctTypes, ", ") +
+			". Checked against the device model when planning.",
+		Validators: []validator.String{
+			stringvalidator.OneOf(ctTypes...),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// unsupportedCTType reports whether model does not support ctType, and
+// returns the types it supports. Unknown models support every type.
+func unsupportedCTType(model, ctType string) ([]string, bool) {
+	supported, ok := ctTypesByModel[model]
+	return supported, ok && !slices.Contains(supported, ctType)
+}
+
+// validateCTTypeForModel checks the planned ct_type against the model of the
+// device. The device is only asked if ct_type or ip change, and devices that
+// cannot be reached are not checked.
+func validateCTTypeForModel(ctx context.Context, credentials *deviceCredentials, req resource.ModifyPlanRequest, diags *diag.Diagnostics) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var ip, ctType, stateIP, stateCTType types.String
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("ip"), &ip)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("ct_type"), &ctType)...)
+	if diags.HasError() || ip.IsUnknown() || ip.IsNull() || ctType.IsUnknown() || ctType.IsNull() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("ip"), &stateIP)...)
+		diags.Append(req.State.GetAttribute(ctx, path.Root("ct_type"), &stateCTType)...)
+		if diags.HasError() || (ip.Equal(stateIP) && ctType.Equal(stateCTType)) {
+			return
+		}
+	}
+
+	client := newDeviceClient(credentials, ip.ValueString())
+	defer client.Close()
+	client.SetTimeout(deviceRequestTimeout)
+
+	info, err := getDeviceInfo(client)
+	if err != nil {
+		diags.AddAttributeWarning(path.Root("ct_type"), "Current transformer type not checked",
+			fmt.Sprintf("Failed to query the device at %s, so ct_type was not checked against its model: %s", ip.ValueString(), err))
+		return
+	}
+	if supported, ok := unsupportedCTType(info.Model, ctType.ValueString()); ok {
+		diags.AddAttributeError(path.Root("ct_type"), "Unsupported current transformer type",
+			fmt.Sprintf("The device at %s is a %s, which supports ct_type %s.", ip.ValueString(), info.Model, strings.Join(supported, ", ")))
+	}
+}
+
+func (c *emConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	reverse := func(phase string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("True if the current direction of phase %s is reversed.", strings.ToUpper(phase)),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+			},
+			"id": schema.Int32Attribute{
+				Required:            true,
+				MarkdownDescription: "The zero-based ID of the EM component to configure (e.g., 0 for the first one).",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the EM instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ct_type": ctTypeSchemaAttribute(),
+			"monitor_phase_sequence": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if the phase sequence is monitored and an error is raised if it is wrong.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"phase_selector": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Phase(s) shown on the device's display and used for the output. Range of values: all, a, b, c.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "a", "b", "c"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reverse": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Per-phase reversal of the measured current direction.",
+				Attributes: map[string]schema.Attribute{
+					"a": reverse("a"),
+					"b": reverse("b"),
+					"c": reverse("c"),
+				},
+			},
+			"reboot": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Reboot the device when the change requires it, so that it takes effect right away. Defaults to true.",
+			},
+		},
+	}
+}
+
+func (c *emConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateCTTypeForModel(ctx, c.credentials, req, &resp.Diagnostics)
+}
+
+func readEMConfig(credentials *deviceCredentials, state *emConfigResourceModel) error {
+	client := newDeviceClient(credentials, state.IP.ValueString())
+	defer client.Close()
+
+	var config emConfig
+	params := map[string]any{"id": state.ID.ValueInt32()}
+	if err := callRPC(client, "EM.GetConfig", params, &config); err != nil {
+		return err
+	}
+
+	state.Name = types.StringPointerValue(config.Name)
+	state.CTType = types.StringPointerValue(config.CTType)
+	state.MonitorPhaseSequence = types.BoolPointerValue(config.MonitorPhaseSequence)
+	state.PhaseSelector = types.StringPointerValue(config.PhaseSelector)
+	// reverse is only refreshed if it is managed. If the device does not
+	// report it, its settings are null.
+	if state.Reverse != nil {
+		reverse := config.Reverse
+		if reverse == nil {
+			reverse = &emReverseConfig{}
+		}
+		state.Reverse.A = types.BoolPointerValue(reverse.A)
+		state.Reverse.B = types.BoolPointerValue(reverse.B)
+		state.Reverse.C = types.BoolPointerValue(reverse.C)
+	}
+	return nil
+}
+
+func (c *emConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state emConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := readEMConfig(c.credentials, &state); err != nil {
+		resp.Diagnostics.AddError("Failed to query EM config", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func setEMConfig(ctx context.Context, credentials *deviceCredentials, plan emConfigResourceModel, diags *diag.Diagnostics) error {
+	em := emConfig{
+		ID:                   int(plan.ID.ValueInt32()),
+		Name:                 stringPointer(plan.Name),
+		CTType:               stringPointer(plan.CTType),
+		MonitorPhaseSequence: boolPointer(plan.MonitorPhaseSequence),
+		PhaseSelector:        stringPointer(plan.PhaseSelector),
+	}
+	if plan.Reverse != nil {
+		em.Reverse = &emReverseConfig{
+			A: boolPointer(plan.Reverse.A),
+			B: boolPointer(plan.Reverse.B),
+			C: boolPointer(plan.Reverse.C),
+		}
+	}
+
+	client := newDeviceClient(credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	var result setConfigResult
+	params := map[string]any{"id": em.ID, "config": em}
+	if err := callRPC(client, "EM.SetConfig", params, &result); err != nil {
+		diags.AddError("Failed to set EM config", err.Error())
+		return err
+	}
+
+	if !result.RestartRequired {
+		return nil
+	}
+	if !plan.Reboot.ValueBool() {
+		diags.AddWarning("Reboot required", "The EM config takes effect after the device is rebooted.")
+		return nil
+	}
+	if err := rebootDevice(ctx, credentials, plan.IP.ValueString()); err != nil {
+		diags.AddError("Failed to reboot device", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (c *emConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan emConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setEMConfig(ctx, c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readEMConfig(c.credentials, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query EM config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *emConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan emConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setEMConfig(ctx, c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readEMConfig(c.credentials, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query EM config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *emConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateIPAndID(ctx, req, resp, "id", "EM")
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reboot"), true)...)
+}
+
+func (c *emConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCTTypesByModel(t *testing.T) {
+	for model, supported := range ctTypesByModel {
+		require.NotEmpty(t, supported, model)
+		for _, ctType := range supported {
+			require.Contains(t, ctTypes, ctType, model)
+		}
+	}
+
+	for _, tc := range []struct {
+		model       string
+		ctType      string
+		unsupported bool
+	}{
+		{"SPEM-003CEBEU", "120A", false},
+		{"SPEM-003CEBEU", "50A", true},
+		{"SPEM-003CEBEU63", "63A", false},
+		{"SPEM-003CEBEU63", "120A", true},
+		{"SPEM-003CEBEU400", "400A", false},
+		{"SPEM-002CEBEU50", "50A", false},
+		{"SPEM-002CEBEU50", "400A", true},
+		{"S3EM-002CXCEU", "50A", false},
+		{"S3EM-002CXCEU", "63A", true},
+		// Unknown models are not checked.
+		{"SNSW-001P16EU", "400A", false},
+	} {
+		_, unsupported := unsupportedCTType(tc.model, tc.ctType)
+		require.Equal(t, tc.unsupported, unsupported, "%s with %s", tc.model, tc.ctType)
+	}
+}
+
+func TestEMConfigModifyPlanChecksCTType(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	client := newFakeDevice(t, func(method string, _ json.RawMessage) (any, *rpcError) {
+		calls++
+		return deviceInfo{ID: "shellyproem50-a0dd6c9ef474", Model: "SPEM-003CEBEU63"}, nil
+	})
+	ip := strings.TrimPrefix(client.BaseURL(), "http://")
+
+	res := NewEMConfigResource()
+	object := func(ctType string) tfsdk.Config {
+		return resourceConfig(t, res, map[string]tftypes.Value{
+			"ip":      tftypes.NewValue(tftypes.String, ip),
+			"id":      tftypes.NewValue(tftypes.Number, 0),
+			"ct_type": tftypes.NewValue(tftypes.String, ctType),
+		})
+	}
+	modifyPlan := func(state, plan tfsdk.Config) resource.ModifyPlanResponse {
+		req := resource.ModifyPlanRequest{
+			Config: plan,
+			Plan:   tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+			State:  tfsdk.State{Schema: state.Schema, Raw: state.Raw},
+		}
+		resp := resource.ModifyPlanResponse{Plan: req.Plan}
+		res.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, req, &resp)
+		return resp
+	}
+	// A resource that is being created has no state.
+	none := object("")
+	none.Raw = tftypes.NewValue(none.Raw.Type(), nil)
+
+	resp := modifyPlan(none, object("120A"))
+	require.True(t, resp.Diagnostics.HasError())
+	require.Equal(t, 1, calls)
+
+	resp = modifyPlan(none, object("63A"))
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	require.Equal(t, 2, calls)
+
+	// An unchanged ct_type is not checked again.
+	resp = modifyPlan(object("120A"), object("120A"))
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	require.Equal(t, 2, calls)
+}
+
+func TestEMConfigModifyPlanWarnsWhenDeviceUnreachable(t *testing.T) {
+	ip := fakeDeviceIP(t, func(string, json.RawMessage) (any, *rpcError) {
+		return nil, &rpcError{Code: 500, Message: "internal error"}
+	})
+	res := NewEMConfigResource()
+	plan := resourceConfig(t, res, map[string]tftypes.Value{
+		"ip":      tftypes.NewValue(tftypes.String, ip),
+		"id":      tftypes.NewValue(tftypes.Number, 0),
+		"ct_type": tftypes.NewValue(tftypes.String, "120A"),
+	})
+	req := resource.ModifyPlanRequest{
+		Config: plan,
+		Plan:   tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+		State:  tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)},
+	}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	res.(resource.ResourceWithModifyPlan).ModifyPlan(context.Background(), req, &resp)
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	require.Len(t, resp.Diagnostics.Warnings(), 1)
+	require.Equal(t, "Current transformer type not checked", resp.Diagnostics.Warnings()[0].Summary())
+}
+
+func TestReadEMConfigUnreportedReverse(t *testing.T) {
+	ip := fakeDeviceIP(t, fakeResult(`{"id": 0, "name": "Mains", "ct_type": "120A"}`))
+
+	state := emConfigResourceModel{
+		IP: types.StringValue(ip),
+		ID: types.Int32Value(0),
+		Reverse: &emReverseModel{
+			A: types.BoolUnknown(),
+			B: types.BoolUnknown(),
+			C: types.BoolUnknown(),
+		},
+	}
+	require.NoError(t, readEMConfig(nil, &state))
+	require.Equal(t, "120A", state.CTType.ValueString())
+	require.True(t, state.Reverse.A.IsNull())
+	require.True(t, state.Reverse.B.IsNull())
+	require.True(t, state.Reverse.C.IsNull())
+}
@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ethConfigResource{}
+	_ resource.ResourceWithConfigure   = &ethConfigResource{}
+	_ resource.ResourceWithImportState = &ethConfigResource{}
+)
+
+func NewEthConfigResource() resource.Resource {
+	return &ethConfigResource{}
+}
+
+type ethConfigResourceModel struct {
+	IP                 types.String `tfsdk:"ip"`
+	Enable             types.Bool   `tfsdk:"enable"`
+	IPv4Mode           types.String `tfsdk:"ipv4mode"`
+	StaticIP           types.String `tfsdk:"static_ip"`
+	Netmask            types.String `tfsdk:"netmask"`
+	GW                 types.String `tfsdk:"gw"`
+	Nameserver         types.String `tfsdk:"nameserver"`
+	AllowAddressChange types.Bool   `tfsdk:"allow_address_change"`
+}
+
+// ethConfig mirrors the config object of Eth.GetConfig / Eth.SetConfig.
+type ethConfig struct {
+	Enable     *bool   `json:"enable,omitempty"`
+	IPv4Mode   *string `json:"ipv4mode,omitempty"`
+	IP         *string `json:"ip,omitempty"`
+	Netmask    *string `json:"netmask,omitempty"`
+	GW         *string `json:"gw,omitempty"`
+	Nameserver *string `json:"nameserver,omitempty"`
+}
+
+type ethStatus struct {
+	IP *string `json:"ip"`
+}
+
+type ethConfigResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *ethConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eth_config"
+}
+
+func (c *ethConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *ethConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the Ethernet configuration of a Shelly Pro device. " +
+			"If the device is reached over Ethernet, changes that would move it away from `ip` are refused unless `allow_address_change` is set.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+			},
+			"enable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if Ethernet is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipv4mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "IPv4 addressing mode. Range of values: dhcp, static.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("dhcp", "static"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"static_ip": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Static IP address (only used when `ipv4mode` is static).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"netmask": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Network mask (only used when `ipv4mode` is static).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"gw": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Gateway IP address (only used when `ipv4mode` is static).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nameserver": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name server IP address (only used when `ipv4mode` is static).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_address_change": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Apply changes even if they make the device unreachable at `ip`. Defaults to false.",
+			},
+		},
+	}
+}
+
+func readEthConfig(credentials *deviceCredentials, ip string, state *ethConfigResourceModel) error {
+	client := newDeviceClient(credentials, ip)
+	defer client.Close()
+
+	var config ethConfig
+	if err := callRPC(client, "Eth.GetConfig", nil, &config); err != nil {
+		return err
+	}
+	ethConfigToState(state, config)
+	return nil
+}
+
+func ethConfigToState(state *ethConfigResourceModel, config ethConfig) {
+	state.Enable = types.BoolPointerValue(config.Enable)
+	state.IPv4Mode = types.StringPointerValue(config.IPv4Mode)
+	state.StaticIP = types.StringPointerValue(config.IP)
+	state.Netmask = types.StringPointerValue(config.Netmask)
+	state.GW = types.StringPointerValue(config.GW)
+	state.Nameserver = types.StringPointerValue(config.Nameserver)
+}
+
+// resolveUnknownEthSettings fills the settings plan leaves to the device
+// from current. They are not sent, so the device keeps them.
+func resolveUnknownEthSettings(plan *ethConfigResourceModel, current ethConfig) {
+	var device ethConfigResourceModel
+	ethConfigToState(&device, current)
+	if plan.Enable.IsUnknown() {
+		plan.Enable = device.Enable
+	}
+	if plan.IPv4Mode.IsUnknown() {
+		plan.IPv4Mode = device.IPv4Mode
+	}
+	if plan.StaticIP.IsUnknown() {
+		plan.StaticIP = device.StaticIP
+	}
+	if plan.Netmask.IsUnknown() {
+		plan.Netmask = device.Netmask
+	}
+	if plan.GW.IsUnknown() {
+		plan.GW = device.GW
+	}
+	if plan.Nameserver.IsUnknown() {
+		plan.Nameserver = device.Nameserver
+	}
+}
+
+func (c *ethConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ethConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := readEthConfig(c.credentials, state.IP.ValueString(), &state); err != nil {
+		resp.Diagnostics.AddError("Failed to query Ethernet config", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ethChangeStrandsAddress returns why applying desired would make the device
+// unreachable at address, or "" if it would not. current and status are the
+// device's Ethernet config and status before the change.
+func ethChangeStrandsAddress(address string, current ethConfig, status ethStatus, desired ethConfig) string {
+	if status.IP == nil || *status.IP != address {
+		// The device is not reached over Ethernet.
+		return ""
+	}
+	if desired.Enable != nil && !*desired.Enable {
+		return "the change disables Ethernet"
+	}
+
+	mode := current.IPv4Mode
+	if desired.IPv4Mode != nil {
+		mode = desired.IPv4Mode
+	}
+	switch {
+	case mode == nil:
+		return ""
+	case *mode == "dhcp" && current.IPv4Mode != nil && *current.IPv4Mode == "static":
+		return "the change switches to DHCP, which may assign a different address"
+	case *mode == "static":
+		staticIP := current.IP
+		if desired.IP != nil {
+			staticIP = desired.IP
+		}
+		if staticIP == nil || *staticIP != address {
+			return fmt.Sprintf("the change moves the static address away from %s", address)
+		}
+	}
+	return ""
+}
+
+// setEthConfig applies plan and returns the address the device is reached
+// at afterwards. If the change moves the device to an address that cannot be
+// predicted, it returns "" without waiting for the device, and fills plan
+// from the config that was applied.
+func setEthConfig(ctx context.Context, credentials *deviceCredentials, plan *ethConfigResourceModel, diags *diag.Diagnostics) (string, error) {
+	address := plan.IP.ValueString()
+	eth := ethConfig{
+		Enable:     boolPointer(plan.Enable),
+		IPv4Mode:   stringPointer(plan.IPv4Mode),
+		IP:         stringPointer(plan.StaticIP),
+		Netmask:    stringPointer(plan.Netmask),
+		GW:         stringPointer(plan.GW),
+		Nameserver: stringPointer(plan.Nameserver),
+	}
+
+	client := newDeviceClient(credentials, address)
+	defer client.Close()
+	client.SetTimeout(deviceRequestTimeout)
+
+	var current ethConfig
+	var status ethStatus
+	if err := callRPC(client, "Eth.GetConfig", nil, &current); err != nil {
+		diags.AddError("Failed to query Ethernet config", err.Error())
+		return "", err
+	}
+	if err := callRPC(client, "Eth.GetStatus", nil, &status); err != nil {
+		diags.AddError("Failed to query Ethernet status", err.Error())
+		return "", err
+	}
+
+	reason := ethChangeStrandsAddress(address, current, status, eth)
+	if reason != "" && !plan.AllowAddressChange.ValueBool() {
+		err := fmt.Errorf("refusing to change the Ethernet config: %s and the device is reached at %s over Ethernet", reason, address)
+		diags.AddAttributeError(path.Root("allow_address_change"), "Unsafe Ethernet config change",
+			err.Error()+". Set allow_address_change to apply it anyway.")
+		return "", err
+	}
+
+	err := callRPC(client, "Eth.SetConfig", map[string]any{"config": eth}, nil)
+	if err != nil && isRPCError(err) {
+		diags.AddError("Failed to set Ethernet config", err.Error())
+		return "", err
+	}
+	if reason != "" {
+		diags.AddWarning("Device address changed",
+			fmt.Sprintf("The Ethernet change may have moved the device away from %s; update ip accordingly.", plan.IP.ValueString()))
+		// The device is expected to move. Read it back at its new static
+		// address if there is one; after switching to DHCP or disabling
+		// Ethernet there is no telling where it went.
+		address = ethStaticAddress(current, eth)
+		if address == "" {
+			resolveUnknownEthSettings(plan, current)
+			return "", nil
+		}
+	}
+
+	if err := waitForDevice(ctx, credentials, address, deviceReconnectTimeout); err != nil {
+		diags.AddError("Device did not come back after Ethernet config change", err.Error())
+		return "", err
+	}
+	return address, nil
+}
+
+func (c *ethConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ethConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	address, err := setEthConfig(ctx, c.credentials, &plan, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	// If the device could not be followed, plan holds what was applied.
+	if address != "" {
+		if err := readEthConfig(c.credentials, address, &plan); err != nil {
+			resp.Diagnostics.AddError("Failed to query Ethernet config", err.Error())
+			return
+		}
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ethStaticAddress returns the static address the device has after desired
+// is applied on top of current, or "" if it does not have one.
+func ethStaticAddress(current, desired ethConfig) string {
+	if desired.Enable != nil && !*desired.Enable {
+		return ""
+	}
+	mode, ip := current.IPv4Mode, current.IP
+	if desired.IPv4Mode != nil {
+		mode = desired.IPv4Mode
+	}
+	if desired.IP != nil {
+		ip = desired.IP
+	}
+	if mode == nil || *mode != "static" || ip == nil {
+		return ""
+	}
+	return *ip
+}
+
+func (c *ethConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ethConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	address, err := setEthConfig(ctx, c.credentials, &plan, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	// If the device could not be followed, plan holds what was applied.
+	if address != "" {
+		if err := readEthConfig(c.credentials, address, &plan); err != nil {
+			resp.Diagnostics.AddError("Failed to query Ethernet config", err.Error())
+			return
+		}
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *ethConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ip"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_address_change"), false)...)
+}
+
+func (c *ethConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
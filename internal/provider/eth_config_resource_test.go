@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthChangeStrandsAddress(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+	disabled := false
+	static := ethConfig{IPv4Mode: ptr("static"), IP: ptr("10.0.0.5")}
+	onEth := ethStatus{IP: ptr("10.0.0.5")}
+
+	// Not reached over Ethernet, so anything goes.
+	require.Empty(t, ethChangeStrandsAddress("192.168.1.5", static, onEth, ethConfig{Enable: &disabled}))
+	require.Empty(t, ethChangeStrandsAddress("10.0.0.5", static, ethStatus{}, ethConfig{Enable: &disabled}))
+
+	require.Empty(t, ethChangeStrandsAddress("10.0.0.5", static, onEth, ethConfig{GW: ptr("10.0.0.1")}))
+	require.Empty(t, ethChangeStrandsAddress("10.0.0.5", static, onEth, ethConfig{IPv4Mode: ptr("static"), IP: ptr("10.0.0.5")}))
+	require.NotEmpty(t, ethChangeStrandsAddress("10.0.0.5", static, onEth, ethConfig{Enable: &disabled}))
+	require.NotEmpty(t, ethChangeStrandsAddress("10.0.0.5", static, onEth, ethConfig{IPv4Mode: ptr("dhcp")}))
+	require.NotEmpty(t, ethChangeStrandsAddress("10.0.0.5", static, onEth, ethConfig{IP: ptr("10.0.0.6")}))
+
+	dhcp := ethConfig{IPv4Mode: ptr("dhcp")}
+	require.Empty(t, ethChangeStrandsAddress("10.0.0.5", dhcp, onEth, ethConfig{IPv4Mode: ptr("dhcp")}))
+	require.Empty(t, ethChangeStrandsAddress("10.0.0.5", dhcp, onEth, ethConfig{IPv4Mode: ptr("static"), IP: ptr("10.0.0.5")}))
+	require.NotEmpty(t, ethChangeStrandsAddress("10.0.0.5", dhcp, onEth, ethConfig{IPv4Mode: ptr("static"), IP: ptr("10.0.0.9")}))
+}
+
+func TestEthStaticAddress(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+	disabled := false
+	static := ethConfig{IPv4Mode: ptr("static"), IP: ptr("10.0.0.5")}
+	dhcp := ethConfig{IPv4Mode: ptr("dhcp")}
+
+	require.Equal(t, "10.0.0.5", ethStaticAddress(static, ethConfig{}))
+	require.Equal(t, "10.0.0.6", ethStaticAddress(static, ethConfig{IP: ptr("10.0.0.6")}))
+	require.Equal(t, "10.0.0.9", ethStaticAddress(dhcp, ethConfig{IPv4Mode: ptr("static"), IP: ptr("10.0.0.9")}))
+	require.Empty(t, ethStaticAddress(static, ethConfig{IPv4Mode: ptr("dhcp")}))
+	require.Empty(t, ethStaticAddress(static, ethConfig{Enable: &disabled}))
+	require.Empty(t, ethStaticAddress(dhcp, ethConfig{}))
+}
+
+func TestSetEthConfigSwitchToDHCP(t *testing.T) {
+	var ip string
+	var sent bool
+	ip = fakeDeviceIP(t, func(method string, _ json.RawMessage) (any, *rpcError) {
+		switch method {
+		case "Eth.GetConfig":
+			return json.RawMessage(`{"enable": true, "ipv4mode": "static", "ip": "10.0.0.5", "netmask": "255.255.255.0", "gw": "10.0.0.1", "nameserver": null}`), nil
+		case "Eth.GetStatus":
+			// The device is reached over Ethernet.
+			return map[string]any{"ip": ip}, nil
+		case "Eth.SetConfig":
+			sent = true
+			return nil, nil
+		}
+		t.Errorf("unexpected call to %s", method)
+		return nil, &rpcError{Code: 404, Message: "No handler for " + method}
+	})
+
+	plan := ethConfigResourceModel{
+		IP:                 types.StringValue(ip),
+		Enable:             types.BoolUnknown(),
+		IPv4Mode:           types.StringValue("dhcp"),
+		StaticIP:           types.StringUnknown(),
+		Netmask:            types.StringUnknown(),
+		GW:                 types.StringUnknown(),
+		Nameserver:         types.StringUnknown(),
+		AllowAddressChange: types.BoolValue(true),
+	}
+	var diags diag.Diagnostics
+	// The new address cannot be predicted, so there is no waiting for the
+	// device.
+	address, err := setEthConfig(context.Background(), nil, &plan, &diags)
+	require.NoError(t, err)
+	require.False(t, diags.HasError(), diags)
+	require.Len(t, diags.Warnings(), 1)
+	require.True(t, sent)
+	require.Empty(t, address)
+
+	require.Equal(t, "dhcp", plan.IPv4Mode.ValueString())
+	require.True(t, plan.Enable.ValueBool())
+	require.Equal(t, "10.0.0.5", plan.StaticIP.ValueString())
+	require.Equal(t, "10.0.0.1", plan.GW.ValueString())
+	require.True(t, plan.Nameserver.IsNull())
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"resty.dev/v3"
+)
+
+// fakeRPCHandler answers a call to method on a fake device. It returns the
+// result, or the error the device reports.
+type fakeRPCHandler func(method string, params json.RawMessage) (any, *rpcError)
+
+// newFakeDevice serves /rpc with handle and returns a client for it. Both
+// are closed when the test ends.
+func newFakeDevice(t *testing.T, handle fakeRPCHandler) *resty.Client {
+	t.Helper()
+	client := newDeviceClient(nil, fakeDeviceIP(t, handle))
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+// fakeDeviceIP serves /rpc with handle and returns the address to reach it
+// at, for code that creates its own clients. The server is closed when the
+// test ends.
+func fakeDeviceIP(t *testing.T, handle fakeRPCHandler) string {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		frame := map[string]any{"id": 1}
+		if result, rpcErr := handle(req.Method, req.Params); rpcErr != nil {
+			frame["error"] = rpcErr
+		} else {
+			frame["result"] = result
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(frame)
+	}))
+	t.Cleanup(server.Close)
+	return strings.TrimPrefix(server.URL, "http://")
+}
+
+// fakeResult returns a handler answering every call with result.
+func fakeResult(result string) fakeRPCHandler {
+	return func(string, json.RawMessage) (any, *rpcError) {
+		return json.RawMessage(result), nil
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &humidityConfigResource{}
+	_ resource.ResourceWithConfigure   = &humidityConfigResource{}
+	_ resource.ResourceWithImportState = &humidityConfigResource{}
+)
+
+func NewHumidityConfigResource() resource.Resource {
+	return &humidityConfigResource{}
+}
+
+type humidityConfigResourceModel struct {
+	sensorConfigResourceModel
+	ReportThr types.Float64 `tfsdk:"report_thr"`
+	Offset    types.Float64 `tfsdk:"offset"`
+}
+
+// humidityConfig mirrors the config object of Humidity.GetConfig /
+// Humidity.SetConfig.
+type humidityConfig struct {
+	sensorConfig
+	ReportThr *float64 `json:"report_thr,omitempty"`
+	Offset    *float64 `json:"offset,omitempty"`
+}
+
+type humidityConfigResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *humidityConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_humidity_config"
+}
+
+func (c *humidityConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *humidityConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := sensorSchemaAttributes("Humidity")
+	attributes["report_thr"] = optionalFloat64Attribute("Relative humidity change in percent that triggers a status update.")
+	attributes["offset"] = signedFloat64Attribute("Offset in percent added to the measured relative humidity.")
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func readHumidityConfig(rpc deviceRPC, state *humidityConfigResourceModel) error {
+	var config humidityConfig
+	if err := getSensorConfig(rpc, &state.sensorConfigResourceModel, "Humidity", &config); err != nil {
+		return err
+	}
+
+	state.Name = types.StringPointerValue(config.Name)
+	state.ReportThr = types.Float64PointerValue(config.ReportThr)
+	state.Offset = types.Float64PointerValue(config.Offset)
+	return nil
+}
+
+func (c *humidityConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state humidityConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rpc, release := httpDeviceRPC(c.credentials, state.IP.ValueString())
+	defer release()
+	if err := readHumidityConfig(rpc, &state); err != nil {
+		if deviceAsleep(state.Sleepy, err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to query Humidity config", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func setHumidityConfig(rpc deviceRPC, plan *humidityConfigResourceModel, diags *diag.Diagnostics) error {
+	config := humidityConfig{
+		sensorConfig: sensorConfigFromPlan(&plan.sensorConfigResourceModel),
+		ReportThr:    float64Pointer(plan.ReportThr),
+		Offset:       float64Pointer(plan.Offset),
+	}
+	return setSensorConfig(rpc, &plan.sensorConfigResourceModel, "Humidity", config, diags)
+}
+
+func (c *humidityConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan humidityConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rpc, release := connectSensor(ctx, c.credentials, &plan.sensorConfigResourceModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer release()
+	if err := setHumidityConfig(rpc, &plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readHumidityConfig(rpc, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query Humidity config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *humidityConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan humidityConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rpc, release := connectSensor(ctx, c.credentials, &plan.sensorConfigResourceModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer release()
+	if err := setHumidityConfig(rpc, &plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readHumidityConfig(rpc, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query Humidity config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *humidityConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSensorState(ctx, req, resp, "Humidity")
+}
+
+func (c *humidityConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
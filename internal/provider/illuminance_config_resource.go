@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &illuminanceConfigResource{}
+	_ resource.ResourceWithConfigure      = &illuminanceConfigResource{}
+	_ resource.ResourceWithImportState    = &illuminanceConfigResource{}
+	_ resource.ResourceWithValidateConfig = &illuminanceConfigResource{}
+)
+
+func NewIlluminanceConfigResource() resource.Resource {
+	return &illuminanceConfigResource{}
+}
+
+type illuminanceConfigResourceModel struct {
+	sensorConfigResourceModel
+	DarkThr   types.Int64 `tfsdk:"dark_thr"`
+	BrightThr types.Int64 `tfsdk:"bright_thr"`
+}
+
+// illuminanceConfig mirrors the config object of Illuminance.GetConfig /
+// Illuminance.SetConfig.
+type illuminanceConfig struct {
+	sensorConfig
+	DarkThr   *int64 `json:"dark_thr,omitempty"`
+	BrightThr *int64 `json:"bright_thr,omitempty"`
+}
+
+type illuminanceConfigResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *illuminanceConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_illuminance_config"
+}
+
+func (c *illuminanceConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *illuminanceConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := sensorSchemaAttributes("Illuminance")
+	attributes["dark_thr"] = luxAttribute("Illuminance in lux below which the illumination is reported as dark.")
+	attributes["bright_thr"] = luxAttribute("Illuminance in lux above which the illumination is reported as bright.")
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// luxAttribute returns an optional, computed illuminance threshold in lux.
+func luxAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: description,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (c *illuminanceConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var dark, bright types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("dark_thr"), &dark)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("bright_thr"), &bright)...)
+	if resp.Diagnostics.HasError() || dark.IsNull() || dark.IsUnknown() || bright.IsNull() || bright.IsUnknown() {
+		return
+	}
+	if dark.ValueInt64() >= bright.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(path.Root("dark_thr"), "Invalid illuminance thresholds",
+			"dark_thr must be lower than bright_thr.")
+	}
+}
+
+func readIlluminanceConfig(rpc deviceRPC, state *illuminanceConfigResourceModel) error {
+	var config illuminanceConfig
+	if err := getSensorConfig(rpc, &state.sensorConfigResourceModel, "Illuminance", &config); err != nil {
+		return err
+	}
+
+	state.Name = types.StringPointerValue(config.Name)
+	state.DarkThr = types.Int64PointerValue(config.DarkThr)
+	state.BrightThr = types.Int64PointerValue(config.BrightThr)
+	return nil
+}
+
+func (c *illuminanceConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state illuminanceConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rpc, release := httpDeviceRPC(c.credentials, state.IP.ValueString())
+	defer release()
+	if err := readIlluminanceConfig(rpc, &state); err != nil {
+		if deviceAsleep(state.Sleepy, err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to query Illuminance config", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func setIlluminanceConfig(rpc deviceRPC, plan *illuminanceConfigResourceModel, diags *diag.Diagnostics) error {
+	config := illuminanceConfig{
+		sensorConfig: sensorConfigFromPlan(&plan.sensorConfigResourceModel),
+		DarkThr:      int64Pointer(plan.DarkThr),
+		BrightThr:    int64Pointer(plan.BrightThr),
+	}
+	return setSensorConfig(rpc, &plan.sensorConfigResourceModel, "Illuminance", config, diags)
+}
+
+func (c *illuminanceConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan illuminanceConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rpc, release := connectSensor(ctx, c.credentials, &plan.sensorConfigResourceModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer release()
+	if err := setIlluminanceConfig(rpc, &plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readIlluminanceConfig(rpc, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query Illuminance config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *illuminanceConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan illuminanceConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rpc, release := connectSensor(ctx, c.credentials, &plan.sensorConfigResourceModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer release()
+	if err := setIlluminanceConfig(rpc, &plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readIlluminanceConfig(rpc, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query Illuminance config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *illuminanceConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSensorState(ctx, req, resp, "Illuminance")
+}
+
+func (c *illuminanceConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
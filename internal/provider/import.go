@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Components added at runtime, such as virtual and BTHome components, get
+// IDs from this range, assigned by the device unless requested explicitly.
+const (
+	dynamicMinID = 200
+	dynamicMaxID = 299
+)
+
+// importStateIPAndID imports resources identified by the device IP and a
+// numeric component ID, given as ip:id. idAttribute is the attribute that
+// holds the ID and kind names the component in error messages.
+func importStateIPAndID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, idAttribute, kind string) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			fmt.Sprintf("Expected format: ip:%s (e.g., 192.168.1.1:0)", idAttribute),
+		)
+		return
+	}
+
+	id, err := strconv.Atoi(parts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Invalid %s ID", kind),
+			fmt.Sprintf("Could not convert ID '%s' to integer: %v", parts[1], err),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(idAttribute), id)...)
+}
+
+// importDynamicComponentState imports components added at runtime, such as
+// virtual and BTHome components, by ip:id, rejecting IDs outside their range.
+func importDynamicComponentState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, component string) {
+	importStateIPAndID(ctx, req, resp, "id", component)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var id types.Int32
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if id.ValueInt32() < dynamicMinID || id.ValueInt32() > dynamicMaxID {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Invalid %s ID", component),
+			fmt.Sprintf("%s components have IDs from %d to %d, got %d. See the %s components listed by Shelly.GetComponents.",
+				component, dynamicMinID, dynamicMaxID, id.ValueInt32(), strings.ToLower(component)),
+		)
+	}
+}
@@ -20,12 +20,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"resty.dev/v3"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &inputConfigResource{}
+	_ resource.ResourceWithConfigure   = &inputConfigResource{}
 	_ resource.ResourceWithImportState = &inputConfigResource{}
 )
 
@@ -42,12 +42,17 @@ type inputConfigResourceModel struct {
 }
 
 type inputConfigResource struct {
+	credentials *deviceCredentials
 }
 
 func (c *inputConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_input_config"
 }
 
+func (c *inputConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
 func (c *inputConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
@@ -102,9 +107,8 @@ func (c *inputConfigResource) Read(ctx context.Context, req resource.ReadRequest
 		ID: int(state.ID.ValueInt32()),
 	}
 
-	client := resty.New()
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
 	defer client.Close()
-	client.SetBaseURL("http://" + state.IP.ValueString())
 
 	statusResp, _, err := statusReq.Do(client)
 	if err != nil {
@@ -125,7 +129,7 @@ func (c *inputConfigResource) Read(ctx context.Context, req resource.ReadRequest
 	resp.Diagnostics.Append(diags...)
 }
 
-func setInputConfig(plan inputConfigResourceModel, diags *diag.Diagnostics) error {
+func setInputConfig(credentials *deviceCredentials, plan inputConfigResourceModel, diags *diag.Diagnostics) error {
 	var inputConfig shelly.InputConfig
 	inputConfig.ID = int(plan.ID.ValueInt32())
 	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
@@ -145,9 +149,8 @@ func setInputConfig(plan inputConfigResourceModel, diags *diag.Diagnostics) erro
 	inputConfig.Enable = &enable
 	statusReq := &shelly.InputSetConfigRequest{Config: inputConfig}
 
-	client := resty.New()
+	client := newDeviceClient(credentials, plan.IP.ValueString())
 	defer client.Close()
-	client.SetBaseURL("http://" + plan.IP.ValueString())
 
 	_, _, err := statusReq.Do(client)
 	if err != nil {
@@ -164,7 +167,7 @@ func (c *inputConfigResource) Create(ctx context.Context, req resource.CreateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := setInputConfig(plan, &resp.Diagnostics); err != nil {
+	if err := setInputConfig(c.credentials, plan, &resp.Diagnostics); err != nil {
 		return
 	}
 	diags = resp.State.Set(ctx, &plan)
@@ -178,7 +181,7 @@ func (c *inputConfigResource) Update(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := setInputConfig(plan, &resp.Diagnostics); err != nil {
+	if err := setInputConfig(c.credentials, plan, &resp.Diagnostics); err != nil {
 		return
 	}
 	diags = resp.State.Set(ctx, &plan)
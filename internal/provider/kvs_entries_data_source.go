@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"resty.dev/v3"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &kvsEntriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &kvsEntriesDataSource{}
+)
+
+func NewKVSEntriesDataSource() datasource.DataSource {
+	return &kvsEntriesDataSource{}
+}
+
+type kvsEntriesDataSource struct {
+	credentials *deviceCredentials
+}
+
+type kvsEntriesModel struct {
+	IP      types.String    `tfsdk:"ip"`
+	Match   types.String    `tfsdk:"match"`
+	Entries []kvsEntryModel `tfsdk:"entries"`
+}
+
+type kvsEntryModel struct {
+	Key       types.String `tfsdk:"key"`
+	Etag      types.String `tfsdk:"etag"`
+	Value     types.String `tfsdk:"value"`
+	ValueJSON types.String `tfsdk:"value_json"`
+}
+
+// kvsItem is an entry returned by KVS.GetMany.
+type kvsItem struct {
+	Key   string          `json:"key"`
+	Etag  string          `json:"etag"`
+	Value json.RawMessage `json:"value"`
+}
+
+func (d *kvsEntriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kvs_entries"
+}
+
+func (d *kvsEntriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (d *kvsEntriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the entries of the key-value store of a device whose keys match a pattern.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the device.",
+			},
+			"match": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Pattern for the keys, where `*` matches any characters, e.g. `garden.*`. Defaults to all keys.",
+			},
+			"entries": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching entries, ordered by key.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The key of the entry.",
+						},
+						"etag": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The etag of the stored value.",
+						},
+						"value": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The value if it is a string, null otherwise.",
+						},
+						"value_json": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The value as JSON.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// getKVSEntries returns the entries matching match, following the pages of
+// KVS.GetMany. Older firmware returns items as an object keyed by key and
+// does not paginate.
+func getKVSEntries(client *resty.Client, match string) ([]kvsItem, error) {
+	var items []kvsItem
+	for {
+		var result struct {
+			Items json.RawMessage `json:"items"`
+			Total *int            `json:"total"`
+		}
+		params := map[string]any{"match": match, "offset": len(items)}
+		if err := callRPC(client, "KVS.GetMany", params, &result); err != nil {
+			return nil, err
+		}
+
+		var page []kvsItem
+		if strings.HasPrefix(strings.TrimSpace(string(result.Items)), "{") {
+			var byKey map[string]kvsItem
+			if err := json.Unmarshal(result.Items, &byKey); err != nil {
+				return nil, err
+			}
+			for key, item := range byKey {
+				item.Key = key
+				page = append(page, item)
+			}
+		} else if len(result.Items) > 0 {
+			if err := json.Unmarshal(result.Items, &page); err != nil {
+				return nil, err
+			}
+		}
+		items = append(items, page...)
+		if result.Total == nil || len(page) == 0 || len(items) >= *result.Total {
+			break
+		}
+	}
+	slices.SortFunc(items, func(a, b kvsItem) int { return strings.Compare(a.Key, b.Key) })
+	return items, nil
+}
+
+func (d *kvsEntriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data kvsEntriesModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(d.credentials, data.IP.ValueString())
+	defer client.Close()
+
+	match := "*"
+	if !data.Match.IsNull() {
+		match = data.Match.ValueString()
+	}
+	items, err := getKVSEntries(client, match)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query KVS entries", err.Error())
+		return
+	}
+
+	data.Entries = make([]kvsEntryModel, 0, len(items))
+	for _, item := range items {
+		entry := kvsEntryModel{
+			Key:       types.StringValue(item.Key),
+			Etag:      types.StringValue(item.Etag),
+			Value:     types.StringNull(),
+			ValueJSON: types.StringValue(string(item.Value)),
+		}
+		var s string
+		if json.Unmarshal(item.Value, &s) == nil {
+			entry.Value = types.StringValue(s)
+		}
+		data.Entries = append(data.Entries, entry)
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
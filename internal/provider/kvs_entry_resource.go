@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"resty.dev/v3"
+)
+
+// kvsMaxKeyLength is the longest key the firmware accepts.
+const kvsMaxKeyLength = 42
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &kvsEntryResource{}
+	_ resource.ResourceWithConfigure      = &kvsEntryResource{}
+	_ resource.ResourceWithImportState    = &kvsEntryResource{}
+	_ resource.ResourceWithValidateConfig = &kvsEntryResource{}
+)
+
+func NewKVSEntryResource() resource.Resource {
+	return &kvsEntryResource{}
+}
+
+type kvsEntryResourceModel struct {
+	IP        types.String `tfsdk:"ip"`
+	Key       types.String `tfsdk:"key"`
+	Value     types.String `tfsdk:"value"`
+	ValueJSON types.String `tfsdk:"value_json"`
+	Etag      types.String `tfsdk:"etag"`
+}
+
+type kvsEntryResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *kvsEntryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kvs_entry"
+}
+
+func (c *kvsEntryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *kvsEntryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an entry of the key-value store of the device, e.g. settings read by scripts with `Shelly.call(\"KVS.Get\", ...)`.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("The key of the entry, at most %d bytes.", kvsMaxKeyLength),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, kvsMaxKeyLength),
+				},
+			},
+			"value": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The value as a string. Exactly one of `value` and `value_json` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("value_json")),
+				},
+			},
+			"value_json": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The value as JSON, e.g. `jsonencode({ threshold = 30 })`.",
+			},
+			"etag": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The etag of the stored value. Updates fail if the entry was changed on the device since it was last read.",
+			},
+		},
+	}
+}
+
+func (c *kvsEntryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var valueJSON types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value_json"), &valueJSON)...)
+	if resp.Diagnostics.HasError() || valueJSON.IsNull() || valueJSON.IsUnknown() {
+		return
+	}
+	if !json.Valid([]byte(valueJSON.ValueString())) {
+		resp.Diagnostics.AddAttributeError(path.Root("value_json"), "Invalid JSON value", "value_json must be valid JSON.")
+	}
+}
+
+// kvsValue returns the value to store for plan.
+func kvsValue(plan *kvsEntryResourceModel) json.RawMessage {
+	if !plan.ValueJSON.IsNull() {
+		return json.RawMessage(plan.ValueJSON.ValueString())
+	}
+	value, _ := json.Marshal(plan.Value.ValueString())
+	return value
+}
+
+// setKVSValue sets state from value. String values go to value unless state
+// holds JSON, and JSON that only differs in formatting is kept as configured.
+func setKVSValue(state *kvsEntryResourceModel, value json.RawMessage) {
+	var s string
+	if state.ValueJSON.IsNull() && json.Unmarshal(value, &s) == nil {
+		state.Value = types.StringValue(s)
+		return
+	}
+	state.Value = types.StringNull()
+	if state.ValueJSON.IsNull() || !jsonEqual([]byte(state.ValueJSON.ValueString()), value) {
+		state.ValueJSON = types.StringValue(string(value))
+	}
+}
+
+// readKVSEntry refreshes state from the device. It returns false if the key
+// no longer exists.
+func readKVSEntry(client *resty.Client, state *kvsEntryResourceModel) (bool, error) {
+	var result struct {
+		Etag  string          `json:"etag"`
+		Value json.RawMessage `json:"value"`
+	}
+	err := callRPC(client, "KVS.Get", map[string]any{"key": state.Key.ValueString()}, &result)
+	if isNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	setKVSValue(state, result.Value)
+	state.Etag = types.StringValue(result.Etag)
+	return true, nil
+}
+
+func (c *kvsEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state kvsEntryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	found, err := readKVSEntry(client, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query KVS entry", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// setKVSEntry stores the value of plan. If etag is set, the device rejects
+// the update when the entry was changed since.
+func setKVSEntry(client *resty.Client, plan *kvsEntryResourceModel, etag types.String, diags *diag.Diagnostics) {
+	params := map[string]any{
+		"key":   plan.Key.ValueString(),
+		"value": kvsValue(plan),
+	}
+	if !etag.IsNull() && !etag.IsUnknown() {
+		params["etag"] = etag.ValueString()
+	}
+
+	var result struct {
+		Etag string `json:"etag"`
+	}
+	if err := callRPC(client, "KVS.Set", params, &result); err != nil {
+		if isRPCError(err) && params["etag"] != nil {
+			diags.AddError("Failed to set KVS entry",
+				fmt.Sprintf("%v. The entry may have been changed on the device since it was last read; refresh and apply again.", err))
+			return
+		}
+		diags.AddError("Failed to set KVS entry", err.Error())
+		return
+	}
+	plan.Etag = types.StringValue(result.Etag)
+}
+
+func (c *kvsEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan kvsEntryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	setKVSEntry(client, &plan, types.StringNull(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *kvsEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state kvsEntryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	setKVSEntry(client, &plan, state.Etag, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *kvsEntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The key follows the last colon, so the IP may include a port.
+	i := strings.LastIndex(req.ID, ":")
+	if i <= 0 || i == len(req.ID)-1 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Expected format: ip:key (e.g., 192.168.1.1:threshold)",
+		)
+		return
+	}
+	ip, key := req.ID[:i], req.ID[i+1:]
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip"), ip)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
+
+func (c *kvsEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state kvsEntryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	if err := callRPC(client, "KVS.Delete", map[string]any{"key": state.Key.ValueString()}, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete KVS entry", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
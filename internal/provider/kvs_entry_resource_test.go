@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeKVSDevice answers KVS.Set, KVS.Get and KVS.GetMany, serving two
+// entries per page in the array format of current firmware.
+type fakeKVSDevice struct {
+	values map[string]json.RawMessage
+	etags  map[string]string
+	sets   int
+}
+
+func (d *fakeKVSDevice) handle(method string, raw json.RawMessage) (any, *rpcError) {
+	var params struct {
+		Key    string          `json:"key"`
+		Value  json.RawMessage `json:"value"`
+		Etag   *string         `json:"etag"`
+		Offset int             `json:"offset"`
+	}
+	_ = json.Unmarshal(raw, &params)
+
+	switch method {
+	case "KVS.Set":
+		if params.Etag != nil && *params.Etag != d.etags[params.Key] {
+			return nil, &rpcError{Code: -103, Message: "etag mismatch"}
+		}
+		d.sets++
+		d.values[params.Key] = params.Value
+		d.etags[params.Key] = fmt.Sprintf("etag%d", d.sets)
+		return map[string]any{"etag": d.etags[params.Key]}, nil
+	case "KVS.Get":
+		value, ok := d.values[params.Key]
+		if !ok {
+			return nil, &rpcError{Code: rpcErrInvalidArgument, Message: "not found"}
+		}
+		return map[string]any{"etag": d.etags[params.Key], "value": value}, nil
+	case "KVS.GetMany":
+		var items []kvsItem
+		for _, key := range slices.Sorted(maps.Keys(d.values)) {
+			items = append(items, kvsItem{Key: key, Etag: d.etags[key], Value: d.values[key]})
+		}
+		end := min(params.Offset+2, len(items))
+		return map[string]any{"items": items[params.Offset:end], "offset": params.Offset, "total": len(items)}, nil
+	}
+	return nil, nil
+}
+
+func TestKVSEntry(t *testing.T) {
+	device := &fakeKVSDevice{values: map[string]json.RawMessage{}, etags: map[string]string{}}
+	client := newFakeDevice(t, device.handle)
+
+	var diags diag.Diagnostics
+	plan := kvsEntryResourceModel{
+		Key:       types.StringValue("config"),
+		Value:     types.StringNull(),
+		ValueJSON: types.StringValue(`{ "threshold": 30 }`),
+	}
+	setKVSEntry(client, &plan, types.StringNull(), &diags)
+	require.False(t, diags.HasError())
+	require.Equal(t, "etag1", plan.Etag.ValueString())
+
+	// Formatting differences are not drift.
+	state := plan
+	found, err := readKVSEntry(client, &state)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, plan, state)
+
+	// A stale etag is rejected.
+	device.etags["config"] = "changed"
+	setKVSEntry(client, &plan, state.Etag, &diags)
+	require.True(t, diags.HasError())
+
+	imported := kvsEntryResourceModel{Key: types.StringValue("name"), Value: types.StringNull(), ValueJSON: types.StringNull()}
+	device.values["name"] = json.RawMessage(`"garden"`)
+	found, err = readKVSEntry(client, &imported)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "garden", imported.Value.ValueString())
+	require.True(t, imported.ValueJSON.IsNull())
+
+	found, err = readKVSEntry(client, &kvsEntryResourceModel{Key: types.StringValue("missing")})
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestGetKVSEntries(t *testing.T) {
+	device := &fakeKVSDevice{
+		values: map[string]json.RawMessage{"a": json.RawMessage(`1`), "b": json.RawMessage(`"x"`), "c": json.RawMessage(`true`)},
+		etags:  map[string]string{"a": "1", "b": "2", "c": "3"},
+	}
+	client := newFakeDevice(t, device.handle)
+
+	items, err := getKVSEntries(client, "*")
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	require.Equal(t, []string{"a", "b", "c"}, []string{items[0].Key, items[1].Key, items[2].Key})
+	require.JSONEq(t, `"x"`, string(items[1].Value))
+}
+
+func TestGetKVSEntriesByKey(t *testing.T) {
+	client := newFakeDevice(t, fakeResult(`{"items": {"b": {"etag": "2", "value": 2}, "a": {"etag": "1", "value": "x"}}}`))
+
+	items, err := getKVSEntries(client, "*")
+	require.NoError(t, err)
+	require.Equal(t, []kvsItem{
+		{Key: "a", Etag: "1", Value: json.RawMessage(`"x"`)},
+		{Key: "b", Etag: "2", Value: json.RawMessage(`2`)},
+	}, items)
+}
+
+func TestKVSEntryImportState(t *testing.T) {
+	ctx := context.Background()
+	res := NewKVSEntryResource()
+	var schemaResp resource.SchemaResponse
+	res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	for id, want := range map[string][]string{
+		"192.168.1.1:threshold":      {"192.168.1.1", "threshold"},
+		"192.168.1.1:8080:threshold": {"192.168.1.1:8080", "threshold"},
+		"192.168.1.1":                nil,
+		"192.168.1.1:":               nil,
+		":threshold":                 nil,
+	} {
+		t.Run(id, func(t *testing.T) {
+			resp := resource.ImportStateResponse{State: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}}
+			res.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: id}, &resp)
+			if want == nil {
+				require.True(t, resp.Diagnostics.HasError())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			var state kvsEntryResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			require.Equal(t, want, []string{state.IP.ValueString(), state.Key.ValueString()})
+		})
+	}
+}
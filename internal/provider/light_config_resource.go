@@ -0,0 +1,402 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &lightConfigResource{}
+	_ resource.ResourceWithConfigure   = &lightConfigResource{}
+	_ resource.ResourceWithImportState = &lightConfigResource{}
+)
+
+var timeOfDayRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+func NewLightConfigResource() resource.Resource {
+	return &lightConfigResource{}
+}
+
+type lightConfigResourceModel struct {
+	IP                    types.String             `tfsdk:"ip"`
+	ID                    types.Int32              `tfsdk:"id"`
+	Name                  types.String             `tfsdk:"name"`
+	InMode                types.String             `tfsdk:"in_mode"`
+	InitialState          types.String             `tfsdk:"initial_state"`
+	AutoOn                types.Bool               `tfsdk:"auto_on"`
+	AutoOnDelay           types.Float64            `tfsdk:"auto_on_delay"`
+	AutoOff               types.Bool               `tfsdk:"auto_off"`
+	AutoOffDelay          types.Float64            `tfsdk:"auto_off_delay"`
+	TransitionDuration    types.Float64            `tfsdk:"transition_duration"`
+	MinBrightnessOnToggle types.Float64            `tfsdk:"min_brightness_on_toggle"`
+	NightMode             *nightModeModel          `tfsdk:"night_mode"`
+	ButtonFadeRate        types.Int64              `tfsdk:"button_fade_rate"`
+	ButtonPresets         *lightButtonPresetsModel `tfsdk:"button_presets"`
+}
+
+type nightModeModel struct {
+	Enable        types.Bool    `tfsdk:"enable"`
+	Brightness    types.Float64 `tfsdk:"brightness"`
+	ActiveBetween types.List    `tfsdk:"active_between"`
+}
+
+type lightButtonPresetsModel struct {
+	ButtonDoublepush *lightButtonPresetModel `tfsdk:"button_doublepush"`
+}
+
+type lightButtonPresetModel struct {
+	Brightness types.Float64 `tfsdk:"brightness"`
+}
+
+// lightConfig mirrors the config object of Light.GetConfig / Light.SetConfig.
+type lightConfig struct {
+	ID                    int                       `json:"id"`
+	Name                  *string                   `json:"name,omitempty"`
+	InMode                *string                   `json:"in_mode,omitempty"`
+	InitialState          *string                   `json:"initial_state,omitempty"`
+	AutoOn                *bool                     `json:"auto_on,omitempty"`
+	AutoOnDelay           *float64                  `json:"auto_on_delay,omitempty"`
+	AutoOff               *bool                     `json:"auto_off,omitempty"`
+	AutoOffDelay          *float64                  `json:"auto_off_delay,omitempty"`
+	TransitionDuration    *float64                  `json:"transition_duration,omitempty"`
+	MinBrightnessOnToggle *float64                  `json:"min_brightness_on_toggle,omitempty"`
+	NightMode             *nightModeConfig          `json:"night_mode,omitempty"`
+	ButtonFadeRate        *int64                    `json:"button_fade_rate,omitempty"`
+	ButtonPresets         *lightButtonPresetsConfig `json:"button_presets,omitempty"`
+}
+
+type nightModeConfig struct {
+	Enable        *bool    `json:"enable,omitempty"`
+	Brightness    *float64 `json:"brightness,omitempty"`
+	ActiveBetween []string `json:"active_between,omitempty"`
+}
+
+type lightButtonPresetsConfig struct {
+	ButtonDoublepush *lightButtonPresetConfig `json:"button_doublepush,omitempty"`
+}
+
+type lightButtonPresetConfig struct {
+	Brightness *float64 `json:"brightness,omitempty"`
+}
+
+type lightConfigResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *lightConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_light_config"
+}
+
+func (c *lightConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+// nightModeSchemaAttribute is shared by the light type components.
+func nightModeSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: "Night mode, which limits the brightness during the given time window.",
+		Attributes: map[string]schema.Attribute{
+			"enable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if night mode is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"brightness": percentAttribute("Brightness level (in percent) used while night mode is active."),
+			"active_between": schema.ListAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Start and end of the night mode window as `HH:MM`, e.g. `[\"22:00\", \"06:30\"]`.",
+				Validators: []validator.List{
+					listvalidator.SizeBetween(2, 2),
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(timeOfDayRegexp, "must be a time of day in HH:MM format"),
+					),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func nightModeFromPlan(ctx context.Context, plan *nightModeModel, diags *diag.Diagnostics) *nightModeConfig {
+	if plan == nil {
+		return nil
+	}
+	nightMode := &nightModeConfig{
+		Enable:     boolPointer(plan.Enable),
+		Brightness: float64Pointer(plan.Brightness),
+	}
+	if !plan.ActiveBetween.IsNull() && !plan.ActiveBetween.IsUnknown() {
+		diags.Append(plan.ActiveBetween.ElementsAs(ctx, &nightMode.ActiveBetween, false)...)
+	}
+	return nightMode
+}
+
+// readNightMode refreshes state if night mode is managed. If the device does
+// not report night mode, its settings are null.
+func readNightMode(ctx context.Context, state *nightModeModel, config *nightModeConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if state == nil {
+		return diags
+	}
+	if config == nil {
+		config = &nightModeConfig{}
+	}
+	state.Enable = types.BoolPointerValue(config.Enable)
+	state.Brightness = types.Float64PointerValue(config.Brightness)
+	if config.ActiveBetween == nil {
+		state.ActiveBetween = types.ListNull(types.StringType)
+	} else {
+		state.ActiveBetween, diags = types.ListValueFrom(ctx, types.StringType, config.ActiveBetween)
+	}
+	return diags
+}
+
+func (c *lightConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+			},
+			"id": schema.Int32Attribute{
+				Required:            true,
+				MarkdownDescription: "The zero-based ID of the light to configure (e.g., 0 for the first light).",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the light instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"in_mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Mode of the associated input. Range of values: follow, flip, activate, detached, dim, dual_dim.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("follow", "flip", "activate", "detached", "dim", "dual_dim"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"initial_state": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Output state to set on power on. Range of values: off, on, restore_last.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("off", "on", "restore_last"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_on": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if the \"Automatic ON\" function is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_on_delay": optionalFloat64Attribute("Seconds to pass until the light is automatically turned on."),
+			"auto_off": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if the \"Automatic OFF\" function is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_off_delay":           optionalFloat64Attribute("Seconds to pass until the light is automatically turned off."),
+			"transition_duration":      optionalFloat64Attribute("Seconds, duration of the transition between brightness levels."),
+			"min_brightness_on_toggle": percentAttribute("Brightness level (in percent) the light is set to at least when toggled on."),
+			"night_mode":               nightModeSchemaAttribute(),
+			"button_fade_rate": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Fade rate when dimming with the buttons, from 1 (slowest) to 5 (fastest).",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"button_presets": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Brightness presets triggered by the buttons.",
+				Attributes: map[string]schema.Attribute{
+					"button_doublepush": schema.SingleNestedAttribute{
+						Optional:            true,
+						MarkdownDescription: "Preset applied on double push.",
+						Attributes: map[string]schema.Attribute{
+							"brightness": percentAttribute("Brightness level (in percent) to set on double push."),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readLightConfig(ctx context.Context, credentials *deviceCredentials, state *lightConfigResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := newDeviceClient(credentials, state.IP.ValueString())
+	defer client.Close()
+
+	var config lightConfig
+	params := map[string]any{"id": state.ID.ValueInt32()}
+	if err := callRPC(client, "Light.GetConfig", params, &config); err != nil {
+		diags.AddError("Failed to query light config", err.Error())
+		return diags
+	}
+
+	state.Name = types.StringPointerValue(config.Name)
+	state.InMode = types.StringPointerValue(config.InMode)
+	state.InitialState = types.StringPointerValue(config.InitialState)
+	state.AutoOn = types.BoolPointerValue(config.AutoOn)
+	state.AutoOnDelay = types.Float64PointerValue(config.AutoOnDelay)
+	state.AutoOff = types.BoolPointerValue(config.AutoOff)
+	state.AutoOffDelay = types.Float64PointerValue(config.AutoOffDelay)
+	state.TransitionDuration = types.Float64PointerValue(config.TransitionDuration)
+	state.MinBrightnessOnToggle = types.Float64PointerValue(config.MinBrightnessOnToggle)
+	state.ButtonFadeRate = types.Int64PointerValue(config.ButtonFadeRate)
+	diags.Append(readNightMode(ctx, state.NightMode, config.NightMode)...)
+	// Button presets are only refreshed if they are managed. A preset the
+	// device does not report leaves its settings null.
+	if state.ButtonPresets != nil && state.ButtonPresets.ButtonDoublepush != nil {
+		doublepush := &lightButtonPresetConfig{}
+		if config.ButtonPresets != nil && config.ButtonPresets.ButtonDoublepush != nil {
+			doublepush = config.ButtonPresets.ButtonDoublepush
+		}
+		state.ButtonPresets.ButtonDoublepush.Brightness = types.Float64PointerValue(doublepush.Brightness)
+	}
+	return diags
+}
+
+func (c *lightConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state lightConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(readLightConfig(ctx, c.credentials, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func setLightConfig(ctx context.Context, credentials *deviceCredentials, plan lightConfigResourceModel, diags *diag.Diagnostics) error {
+	light := lightConfig{
+		ID:                    int(plan.ID.ValueInt32()),
+		Name:                  stringPointer(plan.Name),
+		InMode:                stringPointer(plan.InMode),
+		InitialState:          stringPointer(plan.InitialState),
+		AutoOn:                boolPointer(plan.AutoOn),
+		AutoOnDelay:           float64Pointer(plan.AutoOnDelay),
+		AutoOff:               boolPointer(plan.AutoOff),
+		AutoOffDelay:          float64Pointer(plan.AutoOffDelay),
+		TransitionDuration:    float64Pointer(plan.TransitionDuration),
+		MinBrightnessOnToggle: float64Pointer(plan.MinBrightnessOnToggle),
+		NightMode:             nightModeFromPlan(ctx, plan.NightMode, diags),
+		ButtonFadeRate:        int64Pointer(plan.ButtonFadeRate),
+	}
+	if plan.ButtonPresets != nil {
+		light.ButtonPresets = &lightButtonPresetsConfig{}
+		if plan.ButtonPresets.ButtonDoublepush != nil {
+			light.ButtonPresets.ButtonDoublepush = &lightButtonPresetConfig{
+				Brightness: float64Pointer(plan.ButtonPresets.ButtonDoublepush.Brightness),
+			}
+		}
+	}
+	if diags.HasError() {
+		return errInvalidPlan
+	}
+
+	client := newDeviceClient(credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	params := map[string]any{"id": light.ID, "config": light}
+	if err := callRPC(client, "Light.SetConfig", params, nil); err != nil {
+		diags.AddError("Failed to set light config", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (c *lightConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan lightConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setLightConfig(ctx, c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	resp.Diagnostics.Append(readLightConfig(ctx, c.credentials, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *lightConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan lightConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setLightConfig(ctx, c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	resp.Diagnostics.Append(readLightConfig(ctx, c.credentials, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *lightConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateIPAndID(ctx, req, resp, "id", "light")
+}
+
+func (c *lightConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
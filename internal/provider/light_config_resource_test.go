@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadLightConfigUnreportedSections(t *testing.T) {
+	// The device reports neither night_mode nor button_presets.
+	ip := fakeDeviceIP(t, fakeResult(`{"id": 0, "name": "Hallway", "button_fade_rate": 3}`))
+
+	state := lightConfigResourceModel{
+		IP: types.StringValue(ip),
+		ID: types.Int32Value(0),
+		NightMode: &nightModeModel{
+			Enable:        types.BoolUnknown(),
+			Brightness:    types.Float64Unknown(),
+			ActiveBetween: types.ListUnknown(types.StringType),
+		},
+		ButtonPresets: &lightButtonPresetsModel{
+			ButtonDoublepush: &lightButtonPresetModel{Brightness: types.Float64Unknown()},
+		},
+	}
+	diags := readLightConfig(context.Background(), nil, &state)
+	require.False(t, diags.HasError(), diags)
+
+	require.Equal(t, "Hallway", state.Name.ValueString())
+	require.True(t, state.NightMode.Enable.IsNull())
+	require.True(t, state.NightMode.Brightness.IsNull())
+	require.True(t, state.NightMode.ActiveBetween.IsNull())
+	require.True(t, state.ButtonPresets.ButtonDoublepush.Brightness.IsNull())
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+var mdnsAddr = &net.UDPAddr{IP: net.IPv4(224, 0, 0, 251), Port: 5353}
+
+// resolveMDNS looks up the IPv4 address of host (e.g. shellyplus1-a8032ab12345.local)
+// with a one-shot multicast DNS query. Responders answer such queries
+// directly to the source port, so no multicast listener is needed.
+func resolveMDNS(ctx context.Context, host string, timeout time.Duration) (net.IP, error) {
+	name, err := dnsmessage.NewName(strings.TrimSuffix(host, ".") + ".")
+	if err != nil {
+		return nil, err
+	}
+
+	builder := dnsmessage.NewBuilder(nil, dnsmessage.Header{})
+	if err := builder.StartQuestions(); err != nil {
+		return nil, err
+	}
+	if err := builder.Question(dnsmessage.Question{
+		Name:  name,
+		Type:  dnsmessage.TypeA,
+		Class: dnsmessage.ClassINET,
+	}); err != nil {
+		return nil, err
+	}
+	query, err := builder.Finish()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := net.ListenUDP("udp4", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+	if _, err := conn.WriteTo(query, mdnsAddr); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 9000)
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			return nil, fmt.Errorf("no mDNS answer for %s: %w", host, err)
+		}
+		if ip := findARecord(buf[:n], name); ip != nil {
+			return ip, nil
+		}
+	}
+}
+
+// findARecord returns the address of the first A record for name in msg, or
+// nil if msg is not a matching response.
+func findARecord(msg []byte, name dnsmessage.Name) net.IP {
+	var parser dnsmessage.Parser
+	header, err := parser.Start(msg)
+	if err != nil || !header.Response {
+		return nil
+	}
+	if err := parser.SkipAllQuestions(); err != nil {
+		return nil
+	}
+	for {
+		answer, err := parser.Answer()
+		if err != nil {
+			return nil
+		}
+		a, ok := answer.Body.(*dnsmessage.AResource)
+		if ok && strings.EqualFold(answer.Header.Name.String(), name.String()) {
+			return net.IP(a.A[:])
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func buildMDNSResponse(t *testing.T, name string, a [4]byte) []byte {
+	builder := dnsmessage.NewBuilder(nil, dnsmessage.Header{Response: true, Authoritative: true})
+	require.NoError(t, builder.StartAnswers())
+	require.NoError(t, builder.AResource(dnsmessage.ResourceHeader{
+		Name:  dnsmessage.MustNewName(name),
+		Class: dnsmessage.ClassINET,
+		TTL:   120,
+	}, dnsmessage.AResource{A: a}))
+	msg, err := builder.Finish()
+	require.NoError(t, err)
+	return msg
+}
+
+func TestFindARecord(t *testing.T) {
+	name := dnsmessage.MustNewName("shellyplus1-a8032ab12345.local.")
+
+	msg := buildMDNSResponse(t, "ShellyPlus1-A8032AB12345.local.", [4]byte{192, 168, 1, 42})
+	require.Equal(t, "192.168.1.42", findARecord(msg, name).String())
+
+	msg = buildMDNSResponse(t, "shellypro4pm-c8f09e8a1b2c.local.", [4]byte{192, 168, 1, 43})
+	require.Nil(t, findARecord(msg, name))
+}
@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &mqttConfigResource{}
+	_ resource.ResourceWithConfigure   = &mqttConfigResource{}
+	_ resource.ResourceWithImportState = &mqttConfigResource{}
+)
+
+func NewMQTTConfigResource() resource.Resource {
+	return &mqttConfigResource{}
+}
+
+type mqttConfigResourceModel struct {
+	IP            types.String `tfsdk:"ip"`
+	Enable        types.Bool   `tfsdk:"enable"`
+	Server        types.String `tfsdk:"server"`
+	ClientID      types.String `tfsdk:"client_id"`
+	User          types.String `tfsdk:"user"`
+	Pass          types.String `tfsdk:"pass"`
+	PassWOVersion types.Int64  `tfsdk:"pass_wo_version"`
+	SSLCA         types.String `tfsdk:"ssl_ca"`
+	TopicPrefix   types.String `tfsdk:"topic_prefix"`
+	RPCNtf        types.Bool   `tfsdk:"rpc_ntf"`
+	StatusNtf     types.Bool   `tfsdk:"status_ntf"`
+	UseClientCert types.Bool   `tfsdk:"use_client_cert"`
+	EnableRPC     types.Bool   `tfsdk:"enable_rpc"`
+	EnableControl types.Bool   `tfsdk:"enable_control"`
+	Reboot        types.Bool   `tfsdk:"reboot"`
+}
+
+// mqttConfig mirrors the config object of MQTT.GetConfig / MQTT.SetConfig.
+type mqttConfig struct {
+	Enable        *bool   `json:"enable,omitempty"`
+	Server        *string `json:"server,omitempty"`
+	ClientID      *string `json:"client_id,omitempty"`
+	User          *string `json:"user,omitempty"`
+	Pass          *string `json:"pass,omitempty"`
+	SSLCA         *string `json:"ssl_ca"`
+	TopicPrefix   *string `json:"topic_prefix,omitempty"`
+	RPCNtf        *bool   `json:"rpc_ntf,omitempty"`
+	StatusNtf     *bool   `json:"status_ntf,omitempty"`
+	UseClientCert *bool   `json:"use_client_cert,omitempty"`
+	EnableRPC     *bool   `json:"enable_rpc,omitempty"`
+	EnableControl *bool   `json:"enable_control,omitempty"`
+}
+
+type mqttConfigResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *mqttConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mqtt_config"
+}
+
+func (c *mqttConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *mqttConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+			},
+			"enable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if MQTT connection is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Host name of the MQTT server. Can be followed by port number, e.g. `broker.local:1883`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Identifies each MQTT client that connects to the broker. Defaults to the device ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Username used for authentication with the broker.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pass": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "Password used for authentication with the broker. Write-only, it is never read back from the device or stored in state. Bump `pass_wo_version` to push a changed password.",
+			},
+			"pass_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Arbitrary version number of the write-only password. Change it to push the password to the device again.",
+			},
+			"ssl_ca": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "TLS mode. Unset for no TLS, `*` for TLS without certificate validation, `user_ca.pem` for validation against the user CA, `ca.pem` for validation against the built-in CA bundle.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("*", "user_ca.pem", "ca.pem"),
+				},
+			},
+			"topic_prefix": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Prefix of the topics on which the device publishes and subscribes. Defaults to the device ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rpc_ntf": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if RPC notifications are published on `<topic_prefix>/events/rpc`.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_ntf": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if status notifications are published on `<topic_prefix>/status/<component>`.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"use_client_cert": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if the uploaded client certificate is used for TLS client authentication.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_rpc": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if RPC calls over MQTT are accepted.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_control": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if the device can be controlled via the MQTT control topics.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reboot": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Reboot the device when the change requires it, so that it takes effect right away. Defaults to true.",
+			},
+		},
+	}
+}
+
+func readMQTTConfig(credentials *deviceCredentials, state *mqttConfigResourceModel) error {
+	client := newDeviceClient(credentials, state.IP.ValueString())
+	defer client.Close()
+
+	var config mqttConfig
+	if err := callRPC(client, "MQTT.GetConfig", nil, &config); err != nil {
+		return err
+	}
+	state.Enable = types.BoolPointerValue(config.Enable)
+	state.Server = types.StringPointerValue(config.Server)
+	state.ClientID = types.StringPointerValue(config.ClientID)
+	state.User = types.StringPointerValue(config.User)
+	state.SSLCA = types.StringPointerValue(config.SSLCA)
+	state.TopicPrefix = types.StringPointerValue(config.TopicPrefix)
+	state.RPCNtf = types.BoolPointerValue(config.RPCNtf)
+	state.StatusNtf = types.BoolPointerValue(config.StatusNtf)
+	state.UseClientCert = types.BoolPointerValue(config.UseClientCert)
+	state.EnableRPC = types.BoolPointerValue(config.EnableRPC)
+	state.EnableControl = types.BoolPointerValue(config.EnableControl)
+	return nil
+}
+
+func (c *mqttConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state mqttConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := readMQTTConfig(c.credentials, &state); err != nil {
+		resp.Diagnostics.AddError("Failed to query MQTT config", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// setMQTTConfig applies plan to the device, taking the write-only password
+// from config. MQTT changes only take effect after a reboot, which is done
+// right away unless disabled.
+func setMQTTConfig(ctx context.Context, credentials *deviceCredentials, plan, config mqttConfigResourceModel, diags *diag.Diagnostics) error {
+	mqtt := mqttConfig{
+		Enable:        boolPointer(plan.Enable),
+		Server:        stringPointer(plan.Server),
+		ClientID:      stringPointer(plan.ClientID),
+		User:          stringPointer(plan.User),
+		Pass:          stringPointer(config.Pass),
+		SSLCA:         stringPointer(plan.SSLCA),
+		TopicPrefix:   stringPointer(plan.TopicPrefix),
+		RPCNtf:        boolPointer(plan.RPCNtf),
+		StatusNtf:     boolPointer(plan.StatusNtf),
+		UseClientCert: boolPointer(plan.UseClientCert),
+		EnableRPC:     boolPointer(plan.EnableRPC),
+		EnableControl: boolPointer(plan.EnableControl),
+	}
+
+	client := newDeviceClient(credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	var result setConfigResult
+	if err := callRPC(client, "MQTT.SetConfig", map[string]any{"config": mqtt}, &result); err != nil {
+		diags.AddError("Failed to set MQTT config", err.Error())
+		return err
+	}
+
+	if !result.RestartRequired {
+		return nil
+	}
+	if !plan.Reboot.ValueBool() {
+		diags.AddWarning("Reboot required", "The MQTT config takes effect after the device is rebooted.")
+		return nil
+	}
+	if err := rebootDevice(ctx, credentials, plan.IP.ValueString()); err != nil {
+		diags.AddError("Failed to reboot device", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (c *mqttConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config mqttConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setMQTTConfig(ctx, c.credentials, plan, config, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readMQTTConfig(c.credentials, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query MQTT config", err.Error())
+		return
+	}
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *mqttConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config mqttConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setMQTTConfig(ctx, c.credentials, plan, config, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readMQTTConfig(c.credentials, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query MQTT config", err.Error())
+		return
+	}
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *mqttConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ip"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reboot"), true)...)
+}
+
+func (c *mqttConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+// testSetConfigSSLCA checks that set sends ssl_ca in the config of
+// SetConfig, as null when it is removed from the configuration so that the
+// device clears it. set configures the device at ip with sslCA.
+func testSetConfigSSLCA(t *testing.T, set func(ip string, sslCA types.String) error) {
+	for name, tc := range map[string]struct {
+		sslCA types.String
+		sent  any
+	}{
+		"removed":       {types.StringNull(), nil},
+		"no validation": {types.StringValue("*"), "*"},
+		"built-in CA":   {types.StringValue("ca.pem"), "ca.pem"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var config map[string]any
+			ip := fakeDeviceIP(t, func(method string, raw json.RawMessage) (any, *rpcError) {
+				var params struct {
+					Config map[string]any `json:"config"`
+				}
+				_ = json.Unmarshal(raw, &params)
+				config = params.Config
+				return setConfigResult{}, nil
+			})
+
+			require.NoError(t, set(ip, tc.sslCA))
+			require.Contains(t, config, "ssl_ca")
+			require.Equal(t, tc.sent, config["ssl_ca"])
+		})
+	}
+}
+
+func TestSetMQTTConfigSSLCA(t *testing.T) {
+	testSetConfigSSLCA(t, func(ip string, sslCA types.String) error {
+		plan := mqttConfigResourceModel{
+			IP:     types.StringValue(ip),
+			Enable: types.BoolValue(true),
+			SSLCA:  sslCA,
+			Reboot: types.BoolValue(false),
+		}
+		var diags diag.Diagnostics
+		return setMQTTConfig(context.Background(), nil, plan, mqttConfigResourceModel{}, &diags)
+	})
+}
@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &onboardingResource{}
+	_ resource.ResourceWithConfigure      = &onboardingResource{}
+	_ resource.ResourceWithValidateConfig = &onboardingResource{}
+)
+
+// defaultAPAddress is where a Shelly Gen2 device answers while in AP mode.
+const defaultAPAddress = "192.168.33.1"
+
+func NewOnboardingResource() resource.Resource {
+	return &onboardingResource{}
+}
+
+type onboardingResourceModel struct {
+	APIP       types.String `tfsdk:"ap_ip"`
+	SSID       types.String `tfsdk:"ssid"`
+	Pass       types.String `tfsdk:"pass"`
+	IPv4Mode   types.String `tfsdk:"ipv4mode"`
+	StaticIP   types.String `tfsdk:"static_ip"`
+	Netmask    types.String `tfsdk:"netmask"`
+	GW         types.String `tfsdk:"gw"`
+	Nameserver types.String `tfsdk:"nameserver"`
+	Name       types.String `tfsdk:"name"`
+	TargetIP   types.String `tfsdk:"target_ip"`
+	Timeout    types.Int64  `tfsdk:"timeout"`
+	DeviceID   types.String `tfsdk:"device_id"`
+	Address    types.String `tfsdk:"address"`
+}
+
+type onboardingResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *onboardingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_onboarding"
+}
+
+func (c *onboardingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *onboardingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	requiresReplace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Onboards a factory-fresh Shelly device. The provider connects to the device in AP mode, configures the Wi-Fi station (and optionally the device name), " +
+			"then waits until the device shows up on the target network and exposes its new address. " +
+			"The machine running Terraform must be able to reach both the device's access point and the target network.",
+		Attributes: map[string]schema.Attribute{
+			"ap_ip": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultAPAddress),
+				MarkdownDescription: "The IP address of the device in AP mode. Defaults to `" + defaultAPAddress + "`.",
+				PlanModifiers:       requiresReplace,
+			},
+			"ssid": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "SSID of the network the device should join.",
+				PlanModifiers:       requiresReplace,
+			},
+			"pass": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "Password of the network. Write-only, it is never stored in state.",
+			},
+			"ipv4mode": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("dhcp"),
+				MarkdownDescription: "IPv4 addressing mode on the target network. Range of values: dhcp, static.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("dhcp", "static"),
+				},
+				PlanModifiers: requiresReplace,
+			},
+			"static_ip": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Static IP address (required when `ipv4mode` is static).",
+				PlanModifiers:       requiresReplace,
+			},
+			"netmask": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Network mask (required when `ipv4mode` is static).",
+				PlanModifiers:       requiresReplace,
+			},
+			"gw": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Gateway IP address (only used when `ipv4mode` is static).",
+				PlanModifiers:       requiresReplace,
+			},
+			"nameserver": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name server IP address (only used when `ipv4mode` is static).",
+				PlanModifiers:       requiresReplace,
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name to give the device.",
+				PlanModifiers:       requiresReplace,
+			},
+			"target_ip": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The IP address at which the device is expected on the target network. Defaults to `static_ip`; " +
+					"if neither is set, the device is located via mDNS using its device ID.",
+				PlanModifiers: requiresReplace,
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+				MarkdownDescription: "How long to wait (in seconds) for the device to appear on the target network. Defaults to 300.",
+			},
+			"device_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The device ID (e.g. `shellyplus1-a8032ab12345`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The IP address of the device on the target network. Use it as `ip` of the other resources.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (c *onboardingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config onboardingResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.IPv4Mode.ValueString() != "static" {
+		return
+	}
+	if config.StaticIP.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("static_ip"), "Missing static IP", "static_ip is required when ipv4mode is static.")
+	}
+	if config.Netmask.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("netmask"), "Missing netmask", "netmask is required when ipv4mode is static.")
+	}
+}
+
+func (c *onboardingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Onboarding is a one-shot operation. Once done, the device is managed by
+	// the other resources, so there is nothing to refresh.
+	var state onboardingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// onboardDevice pushes the station config to the device in AP mode and
+// returns its device ID.
+func onboardDevice(credentials *deviceCredentials, plan, config onboardingResourceModel, diags *diag.Diagnostics) (string, error) {
+	client := newDeviceClient(credentials, plan.APIP.ValueString())
+	defer client.Close()
+	client.SetTimeout(deviceRequestTimeout)
+
+	info, err := getDeviceInfo(client)
+	if err != nil {
+		diags.AddError("Failed to reach device in AP mode", err.Error())
+		return "", err
+	}
+
+	if !plan.Name.IsNull() {
+		sysPlan := sysConfigResourceModel{IP: plan.APIP, Name: plan.Name}
+		if err := setSysConfig(credentials, sysPlan, diags); err != nil {
+			return "", err
+		}
+	}
+
+	enable := true
+	sta := &wifiStaConfig{
+		SSID:     stringPointer(plan.SSID),
+		Pass:     stringPointer(config.Pass),
+		Enable:   &enable,
+		IPv4Mode: stringPointer(plan.IPv4Mode),
+	}
+	if plan.IPv4Mode.ValueString() == "static" {
+		sta.IP = stringPointer(plan.StaticIP)
+		sta.Netmask = stringPointer(plan.Netmask)
+		sta.GW = stringPointer(plan.GW)
+		sta.Nameserver = stringPointer(plan.Nameserver)
+	}
+
+	// The device may switch channels to join the network and drop the
+	// connection before answering, which is not an error.
+	err = callRPC(client, "Wifi.SetConfig", map[string]any{"config": wifiConfig{Sta: sta}}, nil)
+	if err != nil && isRPCError(err) {
+		diags.AddError("Failed to set Wi-Fi config", err.Error())
+		return "", err
+	}
+
+	return info.ID, nil
+}
+
+// waitForOnboardedDevice waits until the device with deviceID answers on the
+// target network and returns its address.
+func waitForOnboardedDevice(ctx context.Context, credentials *deviceCredentials, plan onboardingResourceModel, deviceID string) (string, error) {
+	target := plan.TargetIP.ValueString()
+	if target == "" {
+		target = plan.StaticIP.ValueString()
+	}
+	timeout := time.Duration(plan.Timeout.ValueInt64()) * time.Second
+	deadline := time.Now().Add(timeout)
+
+	var lastErr error
+	for {
+		address := target
+		if address == "" {
+			ip, err := resolveMDNS(ctx, deviceID+".local", devicePollInterval)
+			if err != nil {
+				lastErr = err
+			} else {
+				address = ip.String()
+			}
+		}
+		if address != "" {
+			client := newDeviceClient(credentials, address)
+			client.SetTimeout(devicePollInterval * 2)
+			info, err := getDeviceInfo(client)
+			client.Close()
+			switch {
+			case err != nil:
+				lastErr = err
+			case info.ID != deviceID:
+				lastErr = fmt.Errorf("found device %s at %s instead", info.ID, address)
+			default:
+				return address, nil
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("device %s did not show up within %s: %w", deviceID, timeout, lastErr)
+		}
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(devicePollInterval):
+		}
+	}
+}
+
+func (c *onboardingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config onboardingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deviceID, err := onboardDevice(c.credentials, plan, config, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	address, err := waitForOnboardedDevice(ctx, c.credentials, plan, deviceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Device did not join the target network", err.Error())
+		return
+	}
+
+	plan.DeviceID = types.StringValue(deviceID)
+	plan.Address = types.StringValue(address)
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *onboardingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Everything but timeout requires replacement, so there is nothing to apply.
+	var plan onboardingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *onboardingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
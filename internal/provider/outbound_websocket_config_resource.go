@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &outboundWebsocketConfigResource{}
+	_ resource.ResourceWithConfigure   = &outboundWebsocketConfigResource{}
+	_ resource.ResourceWithImportState = &outboundWebsocketConfigResource{}
+)
+
+var websocketURLRegexp = regexp.MustCompile(`^wss?://[^/\s]+(/\S*)?$`)
+
+func NewOutboundWebsocketConfigResource() resource.Resource {
+	return &outboundWebsocketConfigResource{}
+}
+
+type outboundWebsocketConfigResourceModel struct {
+	IP        types.String `tfsdk:"ip"`
+	Enable    types.Bool   `tfsdk:"enable"`
+	Server    types.String `tfsdk:"server"`
+	SSLCA     types.String `tfsdk:"ssl_ca"`
+	Connected types.Bool   `tfsdk:"connected"`
+	Reboot    types.Bool   `tfsdk:"reboot"`
+}
+
+// wsConfig mirrors the config object of WS.GetConfig / WS.SetConfig.
+type wsConfig struct {
+	Enable *bool   `json:"enable,omitempty"`
+	Server *string `json:"server,omitempty"`
+	SSLCA  *string `json:"ssl_ca"`
+}
+
+type wsStatus struct {
+	Connected bool `json:"connected"`
+}
+
+type outboundWebsocketConfigResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *outboundWebsocketConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_outbound_websocket_config"
+}
+
+func (c *outboundWebsocketConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *outboundWebsocketConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+			},
+			"enable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if the outbound WebSocket connection is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "URL of the WebSocket server the device connects to, e.g. `ws://192.168.1.10:8080/shelly`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(websocketURLRegexp, "must be a ws:// or wss:// URL"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ssl_ca": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "TLS mode for `wss://` servers. Unset for no TLS, `*` for TLS without certificate validation, `user_ca.pem` for validation against the user CA, `ca.pem` for validation against the built-in CA bundle.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("*", "user_ca.pem", "ca.pem"),
+				},
+			},
+			"connected": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "True if the device is connected to the WebSocket server.",
+			},
+			"reboot": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Reboot the device when the change requires it, so that it takes effect right away. Defaults to true.",
+			},
+		},
+	}
+}
+
+func readOutboundWebsocketConfig(credentials *deviceCredentials, state *outboundWebsocketConfigResourceModel) error {
+	client := newDeviceClient(credentials, state.IP.ValueString())
+	defer client.Close()
+
+	var config wsConfig
+	if err := callRPC(client, "WS.GetConfig", nil, &config); err != nil {
+		return err
+	}
+	var status wsStatus
+	if err := callRPC(client, "WS.GetStatus", nil, &status); err != nil {
+		return err
+	}
+	state.Enable = types.BoolPointerValue(config.Enable)
+	state.Server = types.StringPointerValue(config.Server)
+	state.SSLCA = types.StringPointerValue(config.SSLCA)
+	state.Connected = types.BoolValue(status.Connected)
+	return nil
+}
+
+func (c *outboundWebsocketConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state outboundWebsocketConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := readOutboundWebsocketConfig(c.credentials, &state); err != nil {
+		resp.Diagnostics.AddError("Failed to query outbound WebSocket config", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func setOutboundWebsocketConfig(ctx context.Context, credentials *deviceCredentials, plan outboundWebsocketConfigResourceModel, diags *diag.Diagnostics) error {
+	ws := wsConfig{
+		Enable: boolPointer(plan.Enable),
+		Server: stringPointer(plan.Server),
+		SSLCA:  stringPointer(plan.SSLCA),
+	}
+
+	client := newDeviceClient(credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	var result setConfigResult
+	if err := callRPC(client, "WS.SetConfig", map[string]any{"config": ws}, &result); err != nil {
+		diags.AddError("Failed to set outbound WebSocket config", err.Error())
+		return err
+	}
+
+	if !result.RestartRequired {
+		return nil
+	}
+	if !plan.Reboot.ValueBool() {
+		diags.AddWarning("Reboot required", "The outbound WebSocket config takes effect after the device is rebooted.")
+		return nil
+	}
+	if err := rebootDevice(ctx, credentials, plan.IP.ValueString()); err != nil {
+		diags.AddError("Failed to reboot device", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (c *outboundWebsocketConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan outboundWebsocketConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setOutboundWebsocketConfig(ctx, c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readOutboundWebsocketConfig(c.credentials, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query outbound WebSocket config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *outboundWebsocketConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan outboundWebsocketConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setOutboundWebsocketConfig(ctx, c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readOutboundWebsocketConfig(c.credentials, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query outbound WebSocket config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *outboundWebsocketConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("ip"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reboot"), true)...)
+}
+
+func (c *outboundWebsocketConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
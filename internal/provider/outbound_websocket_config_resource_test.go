@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSetOutboundWebsocketConfigSSLCA(t *testing.T) {
+	testSetConfigSSLCA(t, func(ip string, sslCA types.String) error {
+		plan := outboundWebsocketConfigResourceModel{
+			IP:     types.StringValue(ip),
+			Server: types.StringValue("ws://192.168.1.10:8080/shelly"),
+			SSLCA:  sslCA,
+			Reboot: types.BoolValue(false),
+		}
+		var diags diag.Diagnostics
+		return setOutboundWebsocketConfig(context.Background(), nil, plan, &diags)
+	})
+}
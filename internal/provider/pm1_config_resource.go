@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &pm1ConfigResource{}
+	_ resource.ResourceWithConfigure   = &pm1ConfigResource{}
+	_ resource.ResourceWithImportState = &pm1ConfigResource{}
+)
+
+func NewPM1ConfigResource() resource.Resource {
+	return &pm1ConfigResource{}
+}
+
+type pm1ConfigResourceModel struct {
+	IP      types.String `tfsdk:"ip"`
+	ID      types.Int32  `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Reverse types.Bool   `tfsdk:"reverse"`
+}
+
+// pm1Config mirrors the config object of PM1.GetConfig / PM1.SetConfig.
+type pm1Config struct {
+	ID      int     `json:"id"`
+	Name    *string `json:"name,omitempty"`
+	Reverse *bool   `json:"reverse,omitempty"`
+}
+
+type pm1ConfigResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *pm1ConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pm1_config"
+}
+
+func (c *pm1ConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *pm1ConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+			},
+			"id": schema.Int32Attribute{
+				Required:            true,
+				MarkdownDescription: "The zero-based ID of the PM1 component to configure (e.g., 0 for the first one).",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the PM1 instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reverse": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if the measured current direction is reversed.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func readPM1Config(credentials *deviceCredentials, state *pm1ConfigResourceModel) error {
+	client := newDeviceClient(credentials, state.IP.ValueString())
+	defer client.Close()
+
+	var config pm1Config
+	params := map[string]any{"id": state.ID.ValueInt32()}
+	if err := callRPC(client, "PM1.GetConfig", params, &config); err != nil {
+		return err
+	}
+
+	state.Name = types.StringPointerValue(config.Name)
+	state.Reverse = types.BoolPointerValue(config.Reverse)
+	return nil
+}
+
+func (c *pm1ConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state pm1ConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := readPM1Config(c.credentials, &state); err != nil {
+		resp.Diagnostics.AddError("Failed to query PM1 config", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func setPM1Config(credentials *deviceCredentials, plan pm1ConfigResourceModel, diags *diag.Diagnostics) error {
+	pm1 := pm1Config{
+		ID:      int(plan.ID.ValueInt32()),
+		Name:    stringPointer(plan.Name),
+		Reverse: boolPointer(plan.Reverse),
+	}
+
+	client := newDeviceClient(credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	params := map[string]any{"id": pm1.ID, "config": pm1}
+	if err := callRPC(client, "PM1.SetConfig", params, nil); err != nil {
+		diags.AddError("Failed to set PM1 config", err.Error())
+		return err
+	}
+	return nil
+}
+
+func (c *pm1ConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan pm1ConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setPM1Config(c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readPM1Config(c.credentials, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query PM1 config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *pm1ConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan pm1ConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setPM1Config(c.credentials, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readPM1Config(c.credentials, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query PM1 config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *pm1ConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateIPAndID(ctx, req, resp, "id", "PM1")
+}
+
+func (c *pm1ConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
@@ -5,14 +5,20 @@ package provider
 
 import (
 	"context"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ provider.Provider = &ShellyProvider{}
+var (
+	_ provider.Provider              = &ShellyProvider{}
+	_ provider.ProviderWithFunctions = &ShellyProvider{}
+)
 
 // ShellyProvider defines the provider implementation.
 type ShellyProvider struct {
@@ -24,6 +30,7 @@ type ShellyProvider struct {
 
 // ShellyProviderModel describes the provider data model.
 type ShellyProviderModel struct {
+	Password types.String `tfsdk:"password"`
 }
 
 func (p *ShellyProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -34,7 +41,14 @@ func (p *ShellyProvider) Metadata(ctx context.Context, req provider.MetadataRequ
 func (p *ShellyProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The Shelly provider allows management and configuration of Shelly Gen2 devices via their local API.",
-		Attributes:          map[string]schema.Attribute{},
+		Attributes: map[string]schema.Attribute{
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				MarkdownDescription: "Password of the devices with authentication enabled. Can also be set with the `SHELLY_PASSWORD` environment variable. " +
+					"Devices whose password is set by `shelly_auth` use that password for the rest of the apply.",
+			},
+		},
 	}
 }
 
@@ -46,6 +60,14 @@ func (p *ShellyProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	password := os.Getenv("SHELLY_PASSWORD")
+	if !data.Password.IsNull() {
+		password = data.Password.ValueString()
+	}
+	credentials := newDeviceCredentials(password)
+	resp.ResourceData = credentials
+	resp.DataSourceData = credentials
 }
 
 func (p *ShellyProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -53,12 +75,56 @@ func (p *ShellyProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewSysConfigResource,
 		NewInputConfigResource,
 		NewSwitchConfigResource,
+		NewWifiConfigResource,
+		NewOnboardingResource,
+		NewEthConfigResource,
+		NewMQTTConfigResource,
+		NewCloudConfigResource,
+		NewBLEConfigResource,
+		NewOutboundWebsocketConfigResource,
+		NewCoverConfigResource,
+		NewCoverCalibrationResource,
+		NewLightConfigResource,
+		NewRGBConfigResource,
+		NewRGBWConfigResource,
+		NewCCTConfigResource,
+		NewEMConfigResource,
+		NewEM1ConfigResource,
+		NewPM1ConfigResource,
+		NewTemperatureConfigResource,
+		NewHumidityConfigResource,
+		NewIlluminanceConfigResource,
+		NewVoltmeterConfigResource,
+		NewSensorAddonPeripheralResource,
+		NewScriptResource,
+		NewScheduleResource,
+		NewWebhookResource,
+		NewKVSEntryResource,
+		NewVirtualBooleanResource,
+		NewVirtualNumberResource,
+		NewVirtualTextResource,
+		NewVirtualEnumResource,
+		NewVirtualButtonResource,
+		NewVirtualGroupResource,
+		NewBTHomeDeviceResource,
+		NewBTHomeSensorResource,
+		NewAuthResource,
 	}
 }
 
 func (p *ShellyProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewShellyDeviceDataSource,
+		NewSensorAddonOneWireDevicesDataSource,
+		NewScriptStatusDataSource,
+		NewKVSEntriesDataSource,
+	}
+}
+
+func (p *ShellyProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewTimespecFunction,
+		NewSunTimespecFunction,
 	}
 }
 
@@ -7,11 +7,34 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/require"
 )
 
+// resourceConfig returns a config of res with the given attribute values
+// and all other attributes null.
+func resourceConfig(t *testing.T, res resource.Resource, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+	var resp resource.SchemaResponse
+	res.Schema(ctx, resource.SchemaRequest{}, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+
+	objectType := resp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+		if value, ok := values[name]; ok {
+			attrs[name] = value
+		}
+	}
+	return tfsdk.Config{Schema: resp.Schema, Raw: tftypes.NewValue(objectType, attrs)}
+}
+
 func TestProviderSchema(t *testing.T) {
 	p := New("test")()
 	ctx := context.Background()
@@ -20,6 +43,7 @@ func TestProviderSchema(t *testing.T) {
 	p.Schema(ctx, req, &resp)
 	require.Empty(t, resp.Diagnostics.Errors())
 	require.NotNil(t, resp.Schema)
+	require.Contains(t, resp.Schema.Attributes, "password")
 }
 
 func TestSysConfigResourceSchema(t *testing.T) {
@@ -60,3 +84,637 @@ func TestSwitchConfigResourceSchema(t *testing.T) {
 	require.Contains(t, reqAttrs, "ip")
 	require.Contains(t, reqAttrs, "id")
 }
+
+func TestWifiConfigResourceSchema(t *testing.T) {
+	res := NewWifiConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "sta")
+	require.Contains(t, reqAttrs, "sta1")
+	require.Contains(t, reqAttrs, "ap")
+	require.Contains(t, reqAttrs, "roam")
+}
+
+func TestOnboardingResourceSchema(t *testing.T) {
+	res := NewOnboardingResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ap_ip")
+	require.Contains(t, reqAttrs, "ssid")
+	require.Contains(t, reqAttrs, "address")
+}
+
+func TestEthConfigResourceSchema(t *testing.T) {
+	res := NewEthConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "ipv4mode")
+	require.Contains(t, reqAttrs, "allow_address_change")
+}
+
+func TestMQTTConfigResourceSchema(t *testing.T) {
+	res := NewMQTTConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "server")
+	require.Contains(t, reqAttrs, "pass")
+	require.Contains(t, reqAttrs, "reboot")
+}
+
+func TestCloudConfigResourceSchema(t *testing.T) {
+	res := NewCloudConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "enable")
+	require.Contains(t, reqAttrs, "connected")
+}
+
+func TestBLEConfigResourceSchema(t *testing.T) {
+	res := NewBLEConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "enable")
+	require.Contains(t, reqAttrs, "rpc_enable")
+	require.Contains(t, reqAttrs, "observer_enable")
+}
+
+func TestOutboundWebsocketConfigResourceSchema(t *testing.T) {
+	res := NewOutboundWebsocketConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "server")
+	require.Contains(t, reqAttrs, "connected")
+}
+
+func TestWebsocketURLRegexp(t *testing.T) {
+	require.True(t, websocketURLRegexp.MatchString("ws://192.168.1.10:8080/shelly"))
+	require.True(t, websocketURLRegexp.MatchString("wss://ha.example.com"))
+	require.False(t, websocketURLRegexp.MatchString("http://ha.example.com"))
+	require.False(t, websocketURLRegexp.MatchString("ws://"))
+}
+
+func TestCoverConfigResourceSchema(t *testing.T) {
+	res := NewCoverConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "obstruction_detection")
+	require.Contains(t, reqAttrs, "safety_switch")
+}
+
+func TestCoverCalibrationResourceSchema(t *testing.T) {
+	res := NewCoverCalibrationResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "triggers")
+	require.Contains(t, reqAttrs, "timeout")
+}
+
+func TestLightConfigResourceSchema(t *testing.T) {
+	res := NewLightConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "night_mode")
+	require.Contains(t, reqAttrs, "button_presets")
+}
+
+func TestTimeOfDayRegexp(t *testing.T) {
+	require.True(t, timeOfDayRegexp.MatchString("00:00"))
+	require.True(t, timeOfDayRegexp.MatchString("23:59"))
+	require.False(t, timeOfDayRegexp.MatchString("24:00"))
+	require.False(t, timeOfDayRegexp.MatchString("7:30"))
+}
+
+func TestRGBConfigResourceSchema(t *testing.T) {
+	res := NewRGBConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "night_mode")
+}
+
+func TestRGBWConfigResourceSchema(t *testing.T) {
+	res := NewRGBWConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "night_mode")
+}
+
+func TestCCTConfigResourceSchema(t *testing.T) {
+	res := NewCCTConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "night_mode")
+	require.Contains(t, reqAttrs, "ct_range")
+}
+
+func TestEMConfigResourceSchema(t *testing.T) {
+	res := NewEMConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "ct_type")
+	require.Contains(t, reqAttrs, "phase_selector")
+	require.Contains(t, reqAttrs, "reverse")
+}
+
+func TestEM1ConfigResourceSchema(t *testing.T) {
+	res := NewEM1ConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "ct_type")
+	require.Contains(t, reqAttrs, "reverse")
+}
+
+func TestPM1ConfigResourceSchema(t *testing.T) {
+	res := NewPM1ConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "reverse")
+}
+
+func TestTemperatureConfigResourceSchema(t *testing.T) {
+	res := NewTemperatureConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "report_thr_c")
+	require.Contains(t, reqAttrs, "offset_c")
+	require.Contains(t, reqAttrs, "sleepy")
+}
+
+func TestHumidityConfigResourceSchema(t *testing.T) {
+	res := NewHumidityConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "report_thr")
+	require.Contains(t, reqAttrs, "offset")
+	require.Contains(t, reqAttrs, "sleepy")
+}
+
+func TestIlluminanceConfigResourceSchema(t *testing.T) {
+	res := NewIlluminanceConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "dark_thr")
+	require.Contains(t, reqAttrs, "bright_thr")
+	require.Contains(t, reqAttrs, "sleepy")
+}
+
+func TestVoltmeterConfigResourceSchema(t *testing.T) {
+	res := NewVoltmeterConfigResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "report_thr")
+	require.Contains(t, reqAttrs, "range")
+	require.Contains(t, reqAttrs, "xvoltage")
+	require.Contains(t, reqAttrs, "sleepy")
+}
+
+func TestSensorAddonPeripheralResourceSchema(t *testing.T) {
+	res := NewSensorAddonPeripheralResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "type")
+	require.Contains(t, reqAttrs, "cid")
+	require.Contains(t, reqAttrs, "addr")
+	require.Contains(t, reqAttrs, "components")
+}
+
+func TestSensorAddonOneWireDevicesDataSourceSchema(t *testing.T) {
+	res := NewSensorAddonOneWireDevicesDataSource()
+	ctx := context.Background()
+	var req datasource.SchemaRequest
+	var resp datasource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "devices")
+}
+
+func TestComponentCID(t *testing.T) {
+	cid, err := componentCID("temperature:100")
+	require.NoError(t, err)
+	require.Equal(t, int32(100), cid)
+
+	_, err = componentCID("temperature")
+	require.Error(t, err)
+	_, err = componentCID("temperature:abc")
+	require.Error(t, err)
+}
+
+func TestScriptResourceSchema(t *testing.T) {
+	res := NewScriptResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "name")
+	require.Contains(t, reqAttrs, "enable")
+	require.Contains(t, reqAttrs, "code")
+	require.Contains(t, reqAttrs, "lint")
+	require.Contains(t, reqAttrs, "start")
+	require.Contains(t, reqAttrs, "require_running")
+	require.Contains(t, reqAttrs, "running_grace_period")
+	require.Contains(t, reqAttrs, "code_sha256")
+}
+
+func TestScriptStatusDataSourceSchema(t *testing.T) {
+	res := NewScriptStatusDataSource()
+	ctx := context.Background()
+	var req datasource.SchemaRequest
+	var resp datasource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "running")
+	require.Contains(t, reqAttrs, "mem_used")
+	require.Contains(t, reqAttrs, "mem_peak")
+	require.Contains(t, reqAttrs, "errors")
+}
+
+func TestScriptFailure(t *testing.T) {
+	require.Empty(t, scriptFailure(&scriptStatus{Running: true}))
+	require.Equal(t, "script is stopped (errors: syntax_error)", scriptFailure(&scriptStatus{Errors: []string{"syntax_error"}}))
+	require.Equal(t, "script is stopped (no error reported)", scriptFailure(&scriptStatus{}))
+
+	msg := "Uncaught ReferenceError: foo is not defined"
+	require.Equal(t, "script is running (errors: crashed; "+msg+")",
+		scriptFailure(&scriptStatus{Running: true, Errors: []string{"crashed"}, ErrorMsg: &msg}))
+}
+
+func TestScheduleResource(t *testing.T) {
+	res := NewScheduleResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "enable")
+	require.Contains(t, reqAttrs, "timespec")
+	require.Contains(t, reqAttrs, "calls")
+}
+
+func TestJSONEqual(t *testing.T) {
+	require.True(t, jsonEqual([]byte(`{"id":0,"on":true}`), []byte(`{ "on": true, "id": 0 }`)))
+	require.False(t, jsonEqual([]byte(`{"id":0,"on":true}`), []byte(`{"id":0,"on":false}`)))
+	require.False(t, jsonEqual([]byte(`{"id":0`), []byte(`{"id":0}`)))
+}
+
+func TestWebhookResource(t *testing.T) {
+	res := NewWebhookResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "cid")
+	require.Contains(t, reqAttrs, "event")
+	require.Contains(t, reqAttrs, "name")
+	require.Contains(t, reqAttrs, "enable")
+	require.Contains(t, reqAttrs, "urls")
+	require.Contains(t, reqAttrs, "condition")
+	require.Contains(t, reqAttrs, "repeat_period")
+	require.Contains(t, reqAttrs, "active_between")
+}
+
+func TestKVSEntryResource(t *testing.T) {
+	res := NewKVSEntryResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "key")
+	require.Contains(t, reqAttrs, "value")
+	require.Contains(t, reqAttrs, "value_json")
+	require.Contains(t, reqAttrs, "etag")
+}
+
+func TestKVSEntriesDataSource(t *testing.T) {
+	res := NewKVSEntriesDataSource()
+	ctx := context.Background()
+	var req datasource.SchemaRequest
+	var resp datasource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "match")
+	require.Contains(t, reqAttrs, "entries")
+}
+
+func TestVirtualBooleanResource(t *testing.T) {
+	res := NewVirtualBooleanResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "name")
+	require.Contains(t, reqAttrs, "icon")
+	require.Contains(t, reqAttrs, "view")
+	require.Contains(t, reqAttrs, "titles")
+	require.Contains(t, reqAttrs, "persisted")
+	require.Contains(t, reqAttrs, "default_value")
+}
+
+func TestVirtualNumberResource(t *testing.T) {
+	res := NewVirtualNumberResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "name")
+	require.Contains(t, reqAttrs, "icon")
+	require.Contains(t, reqAttrs, "view")
+	require.Contains(t, reqAttrs, "unit")
+	require.Contains(t, reqAttrs, "step")
+	require.Contains(t, reqAttrs, "min")
+	require.Contains(t, reqAttrs, "max")
+	require.Contains(t, reqAttrs, "persisted")
+	require.Contains(t, reqAttrs, "default_value")
+}
+
+func TestVirtualTextResource(t *testing.T) {
+	res := NewVirtualTextResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "name")
+	require.Contains(t, reqAttrs, "icon")
+	require.Contains(t, reqAttrs, "view")
+	require.Contains(t, reqAttrs, "max_len")
+	require.Contains(t, reqAttrs, "persisted")
+	require.Contains(t, reqAttrs, "default_value")
+}
+
+func TestVirtualEnumResource(t *testing.T) {
+	res := NewVirtualEnumResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "name")
+	require.Contains(t, reqAttrs, "icon")
+	require.Contains(t, reqAttrs, "view")
+	require.Contains(t, reqAttrs, "options")
+	require.Contains(t, reqAttrs, "titles")
+	require.Contains(t, reqAttrs, "persisted")
+	require.Contains(t, reqAttrs, "default_value")
+}
+
+func TestVirtualButtonResource(t *testing.T) {
+	res := NewVirtualButtonResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "name")
+	require.Contains(t, reqAttrs, "icon")
+}
+
+func TestVirtualGroupResource(t *testing.T) {
+	res := NewVirtualGroupResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "name")
+	require.Contains(t, reqAttrs, "icon")
+	require.Contains(t, reqAttrs, "members")
+}
+
+func TestBTHomeDeviceResource(t *testing.T) {
+	res := NewBTHomeDeviceResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "addr")
+	require.Contains(t, reqAttrs, "name")
+	require.Contains(t, reqAttrs, "key")
+}
+
+func TestBTHomeSensorResource(t *testing.T) {
+	res := NewBTHomeSensorResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "id")
+	require.Contains(t, reqAttrs, "addr")
+	require.Contains(t, reqAttrs, "obj_id")
+	require.Contains(t, reqAttrs, "idx")
+	require.Contains(t, reqAttrs, "name")
+}
+
+func TestAuthResource(t *testing.T) {
+	res := NewAuthResource()
+	ctx := context.Background()
+	var req resource.SchemaRequest
+	var resp resource.SchemaResponse
+	res.Schema(ctx, req, &resp)
+	require.Empty(t, resp.Diagnostics.Errors())
+	require.NotNil(t, resp.Schema)
+	reqAttrs := resp.Schema.Attributes
+	require.Contains(t, reqAttrs, "ip")
+	require.Contains(t, reqAttrs, "password")
+	require.Contains(t, reqAttrs, "password_version")
+	require.Contains(t, reqAttrs, "realm")
+}
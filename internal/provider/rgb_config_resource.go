@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &colorConfigResource{}
+	_ resource.ResourceWithConfigure   = &colorConfigResource{}
+	_ resource.ResourceWithImportState = &colorConfigResource{}
+)
+
+func NewRGBConfigResource() resource.Resource {
+	return &colorConfigResource{component: "RGB"}
+}
+
+func NewRGBWConfigResource() resource.Resource {
+	return &colorConfigResource{component: "RGBW"}
+}
+
+// colorConfigResourceModel holds the settings shared by the RGB, RGBW and CCT
+// components.
+type colorConfigResourceModel struct {
+	IP                    types.String    `tfsdk:"ip"`
+	ID                    types.Int32     `tfsdk:"id"`
+	Name                  types.String    `tfsdk:"name"`
+	InitialState          types.String    `tfsdk:"initial_state"`
+	AutoOn                types.Bool      `tfsdk:"auto_on"`
+	AutoOnDelay           types.Float64   `tfsdk:"auto_on_delay"`
+	AutoOff               types.Bool      `tfsdk:"auto_off"`
+	AutoOffDelay          types.Float64   `tfsdk:"auto_off_delay"`
+	TransitionDuration    types.Float64   `tfsdk:"transition_duration"`
+	MinBrightnessOnToggle types.Float64   `tfsdk:"min_brightness_on_toggle"`
+	NightMode             *nightModeModel `tfsdk:"night_mode"`
+}
+
+// colorConfig mirrors the config object of <RGB|RGBW|CCT>.GetConfig / SetConfig.
+type colorConfig struct {
+	ID                    int              `json:"id"`
+	Name                  *string          `json:"name,omitempty"`
+	InitialState          *string          `json:"initial_state,omitempty"`
+	AutoOn                *bool            `json:"auto_on,omitempty"`
+	AutoOnDelay           *float64         `json:"auto_on_delay,omitempty"`
+	AutoOff               *bool            `json:"auto_off,omitempty"`
+	AutoOffDelay          *float64         `json:"auto_off_delay,omitempty"`
+	TransitionDuration    *float64         `json:"transition_duration,omitempty"`
+	MinBrightnessOnToggle *float64         `json:"min_brightness_on_toggle,omitempty"`
+	NightMode             *nightModeConfig `json:"night_mode,omitempty"`
+}
+
+// colorConfigResource manages the RGB or RGBW component, which share their
+// configuration.
+type colorConfigResource struct {
+	component   string
+	credentials *deviceCredentials
+}
+
+func (c *colorConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + strings.ToLower(c.component) + "_config"
+}
+
+func (c *colorConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+// colorSchemaAttributes returns the attributes shared by the RGB, RGBW and
+// CCT components.
+func colorSchemaAttributes(component string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"ip": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The IP address of the Shelly device.",
+		},
+		"id": schema.Int32Attribute{
+			Required:            true,
+			MarkdownDescription: fmt.Sprintf("The zero-based ID of the %s component to configure (e.g., 0 for the first one).", component),
+		},
+		"name": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("Name of the %s instance.", component),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"initial_state": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Output state to set on power on. Range of values: off, on, restore_last.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("off", "on", "restore_last"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"auto_on": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "True if the \"Automatic ON\" function is enabled.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"auto_on_delay": optionalFloat64Attribute("Seconds to pass until the output is automatically turned on."),
+		"auto_off": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "True if the \"Automatic OFF\" function is enabled.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"auto_off_delay":           optionalFloat64Attribute("Seconds to pass until the output is automatically turned off."),
+		"transition_duration":      optionalFloat64Attribute("Seconds, duration of the transition between brightness levels and colors."),
+		"min_brightness_on_toggle": percentAttribute("Brightness level (in percent) the output is set to at least when toggled on."),
+		"night_mode":               nightModeSchemaAttribute(),
+	}
+}
+
+func colorConfigFromPlan(ctx context.Context, plan *colorConfigResourceModel, diags *diag.Diagnostics) colorConfig {
+	return colorConfig{
+		ID:                    int(plan.ID.ValueInt32()),
+		Name:                  stringPointer(plan.Name),
+		InitialState:          stringPointer(plan.InitialState),
+		AutoOn:                boolPointer(plan.AutoOn),
+		AutoOnDelay:           float64Pointer(plan.AutoOnDelay),
+		AutoOff:               boolPointer(plan.AutoOff),
+		AutoOffDelay:          float64Pointer(plan.AutoOffDelay),
+		TransitionDuration:    float64Pointer(plan.TransitionDuration),
+		MinBrightnessOnToggle: float64Pointer(plan.MinBrightnessOnToggle),
+		NightMode:             nightModeFromPlan(ctx, plan.NightMode, diags),
+	}
+}
+
+func readColorConfig(ctx context.Context, state *colorConfigResourceModel, config *colorConfig) diag.Diagnostics {
+	state.Name = types.StringPointerValue(config.Name)
+	state.InitialState = types.StringPointerValue(config.InitialState)
+	state.AutoOn = types.BoolPointerValue(config.AutoOn)
+	state.AutoOnDelay = types.Float64PointerValue(config.AutoOnDelay)
+	state.AutoOff = types.BoolPointerValue(config.AutoOff)
+	state.AutoOffDelay = types.Float64PointerValue(config.AutoOffDelay)
+	state.TransitionDuration = types.Float64PointerValue(config.TransitionDuration)
+	state.MinBrightnessOnToggle = types.Float64PointerValue(config.MinBrightnessOnToggle)
+	return readNightMode(ctx, state.NightMode, config.NightMode)
+}
+
+func (c *colorConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: colorSchemaAttributes(c.component),
+	}
+}
+
+func (c *colorConfigResource) read(ctx context.Context, state *colorConfigResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	var config colorConfig
+	params := map[string]any{"id": state.ID.ValueInt32()}
+	if err := callRPC(client, c.component+".GetConfig", params, &config); err != nil {
+		diags.AddError(fmt.Sprintf("Failed to query %s config", c.component), err.Error())
+		return diags
+	}
+	return readColorConfig(ctx, state, &config)
+}
+
+func (c *colorConfigResource) set(ctx context.Context, plan colorConfigResourceModel, diags *diag.Diagnostics) error {
+	config := colorConfigFromPlan(ctx, &plan, diags)
+	if diags.HasError() {
+		return errInvalidPlan
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	params := map[string]any{"id": config.ID, "config": config}
+	if err := callRPC(client, c.component+".SetConfig", params, nil); err != nil {
+		diags.AddError(fmt.Sprintf("Failed to set %s config", c.component), err.Error())
+		return err
+	}
+	return nil
+}
+
+func (c *colorConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state colorConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(c.read(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *colorConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan colorConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := c.set(ctx, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	resp.Diagnostics.Append(c.read(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *colorConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan colorConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := c.set(ctx, plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	resp.Diagnostics.Append(c.read(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *colorConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateIPAndID(ctx, req, resp, "id", c.component)
+}
+
+func (c *colorConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
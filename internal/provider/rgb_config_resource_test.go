@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColorConfigReadUnreportedNightMode(t *testing.T) {
+	for _, component := range []string{"RGB", "RGBW"} {
+		t.Run(component, func(t *testing.T) {
+			var method string
+			ip := fakeDeviceIP(t, func(m string, _ json.RawMessage) (any, *rpcError) {
+				method = m
+				// The device does not report night_mode.
+				return json.RawMessage(`{"id": 0, "name": "Strip"}`), nil
+			})
+
+			state := colorConfigResourceModel{
+				IP: types.StringValue(ip),
+				ID: types.Int32Value(0),
+				NightMode: &nightModeModel{
+					Enable:        types.BoolUnknown(),
+					Brightness:    types.Float64Unknown(),
+					ActiveBetween: types.ListUnknown(types.StringType),
+				},
+			}
+			res := &colorConfigResource{component: component}
+			diags := res.read(context.Background(), &state)
+			require.False(t, diags.HasError(), diags)
+
+			require.Equal(t, component+".GetConfig", method)
+			require.Equal(t, "Strip", state.Name.ValueString())
+			require.True(t, state.NightMode.Enable.IsNull())
+			require.True(t, state.NightMode.Brightness.IsNull())
+			require.True(t, state.NightMode.ActiveBetween.IsNull())
+		})
+	}
+}
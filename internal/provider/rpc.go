@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"resty.dev/v3"
+)
+
+// rpcRequest is the frame accepted by the device's /rpc endpoint.
+type rpcRequest struct {
+	ID     int    `json:"id"`
+	Method string `json:"method"`
+	Params any    `json:"params,omitempty"`
+}
+
+// rpcResponse is the frame returned by the device's /rpc endpoint.
+type rpcResponse struct {
+	ID     int             `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+// rpcError is an error reported by the device itself, as opposed to a
+// transport error. Receiving one means the device is reachable.
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+}
+
+// isRPCError reports whether err was returned by the device rather than
+// caused by a failed connection.
+func isRPCError(err error) bool {
+	var rpcErr *rpcError
+	return errors.As(err, &rpcErr)
+}
+
+// newDeviceClient returns a client for the device at ip that authenticates
+// with credentials, which may be nil.
+func newDeviceClient(credentials *deviceCredentials, ip string) *resty.Client {
+	client := resty.New()
+	client.SetBaseURL("http://" + ip)
+	credentials.apply(client, ip)
+	return client
+}
+
+// callRPC invokes method on the device and decodes the result into out.
+// out may be nil if the result is not needed.
+func callRPC(client *resty.Client, method string, params any, out any) error {
+	var frame rpcResponse
+	resp, err := client.R().
+		SetBody(rpcRequest{ID: 1, Method: method, Params: params}).
+		SetResult(&frame).
+		SetError(&frame).
+		Post("/rpc")
+	if err != nil {
+		return err
+	}
+	if frame.Error == nil && resp.IsError() {
+		return fmt.Errorf("%s: unexpected response status %s", method, resp.Status())
+	}
+	return decodeRPCResult(method, frame, out)
+}
+
+// decodeRPCResult returns the error reported in frame, or decodes its result
+// into out. out may be nil if the result is not needed.
+func decodeRPCResult(method string, frame rpcResponse, out any) error {
+	if frame.Error != nil {
+		return frame.Error
+	}
+	if out == nil || len(frame.Result) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(frame.Result, out); err != nil {
+		return fmt.Errorf("%s: failed to decode result: %w", method, err)
+	}
+	return nil
+}
+
+// deviceRPC invokes method on a device over some transport and decodes the
+// result into out, like callRPC.
+type deviceRPC func(method string, params any, out any) error
+
+// httpDeviceRPC returns a deviceRPC for the device at ip and a function
+// that releases its client.
+func httpDeviceRPC(credentials *deviceCredentials, ip string) (deviceRPC, func()) {
+	client := newDeviceClient(credentials, ip)
+	rpc := func(method string, params any, out any) error {
+		return callRPC(client, method, params, out)
+	}
+	return rpc, func() { client.Close() }
+}
+
+// deviceInfo is the result of Shelly.GetDeviceInfo.
+type deviceInfo struct {
+	Name       *string `json:"name"`
+	ID         string  `json:"id"`
+	MAC        string  `json:"mac"`
+	Model      string  `json:"model"`
+	Gen        int     `json:"gen"`
+	FWID       string  `json:"fw_id"`
+	Ver        string  `json:"ver"`
+	App        string  `json:"app"`
+	AuthEn     bool    `json:"auth_en"`
+	AuthDomain *string `json:"auth_domain"`
+}
+
+func getDeviceInfo(client *resty.Client) (*deviceInfo, error) {
+	var info deviceInfo
+	if err := callRPC(client, "Shelly.GetDeviceInfo", nil, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// setConfigResult is the result of the <Component>.SetConfig methods.
+type setConfigResult struct {
+	RestartRequired bool `json:"restart_required"`
+}
+
+// rebootDevice restarts the device and waits until it is back online.
+func rebootDevice(ctx context.Context, credentials *deviceCredentials, ip string) error {
+	client := newDeviceClient(credentials, ip)
+	defer client.Close()
+	client.SetTimeout(deviceRequestTimeout)
+
+	if err := callRPC(client, "Shelly.Reboot", nil, nil); err != nil {
+		return err
+	}
+	// Give the device a moment to actually go down before polling it.
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(devicePollInterval * 2):
+	}
+	return waitForDevice(ctx, credentials, ip, deviceReconnectTimeout)
+}
+
+const (
+	// deviceRequestTimeout bounds requests that may not get an answer because
+	// the change they apply disconnects the device.
+	deviceRequestTimeout = 10 * time.Second
+	// deviceReconnectTimeout is how long to wait for a device to come back
+	// after a change that makes it drop off the network.
+	deviceReconnectTimeout = 2 * time.Minute
+	devicePollInterval     = 2 * time.Second
+)
+
+// waitForDevice polls ip until the device answers Shelly.GetDeviceInfo or
+// timeout expires.
+func waitForDevice(ctx context.Context, credentials *deviceCredentials, ip string, timeout time.Duration) error {
+	client := newDeviceClient(credentials, ip)
+	defer client.Close()
+	client.SetTimeout(devicePollInterval * 2)
+
+	deadline := time.Now().Add(timeout)
+	for {
+		_, err := getDeviceInfo(client)
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("device at %s did not respond within %s: %w", ip, timeout, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(devicePollInterval):
+		}
+	}
+}
@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"resty.dev/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &scheduleResource{}
+	_ resource.ResourceWithConfigure      = &scheduleResource{}
+	_ resource.ResourceWithImportState    = &scheduleResource{}
+	_ resource.ResourceWithValidateConfig = &scheduleResource{}
+)
+
+func NewScheduleResource() resource.Resource {
+	return &scheduleResource{}
+}
+
+type scheduleResourceModel struct {
+	IP       types.String        `tfsdk:"ip"`
+	ID       types.Int32         `tfsdk:"id"`
+	Enable   types.Bool          `tfsdk:"enable"`
+	Timespec types.String        `tfsdk:"timespec"`
+	Calls    []scheduleCallModel `tfsdk:"calls"`
+}
+
+type scheduleCallModel struct {
+	Method types.String `tfsdk:"method"`
+	Params types.String `tfsdk:"params"`
+}
+
+// scheduleJob mirrors a job of Schedule.List and the params of
+// Schedule.Create / Schedule.Update.
+type scheduleJob struct {
+	ID       *int32         `json:"id,omitempty"`
+	Enable   bool           `json:"enable"`
+	Timespec string         `json:"timespec"`
+	Calls    []scheduleCall `json:"calls"`
+}
+
+type scheduleCall struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+type scheduleResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *scheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule"
+}
+
+func (c *scheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *scheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a schedule job, which invokes RPC methods on the device at the times given by a timespec.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the schedule job, assigned by the device.",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "True if the schedule job is enabled. Defaults to true.",
+			},
+			"timespec": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "When to run the calls, as a cron-like timespec with seconds, e.g. `0 0 7 * * MON-FRI` or `@sunset+30m * * *`. See the `timespec` and `sun_timespec` functions.",
+				Validators: []validator.String{
+					timespecValidator{},
+				},
+			},
+			"calls": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "RPC calls to invoke, in order.",
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 5),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"method": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The RPC method, e.g. `Switch.Set`.",
+						},
+						"params": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The parameters of the method as a JSON object, e.g. `jsonencode({ id = 0, on = true })`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (c *scheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// The calls may only be known at apply time, e.g. when built from
+	// other resources.
+	var list types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("calls"), &list)...)
+	if resp.Diagnostics.HasError() || list.IsNull() || list.IsUnknown() {
+		return
+	}
+	var calls []types.Object
+	resp.Diagnostics.Append(list.ElementsAs(ctx, &calls, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i, object := range calls {
+		if object.IsNull() || object.IsUnknown() {
+			continue
+		}
+		var call scheduleCallModel
+		resp.Diagnostics.Append(object.As(ctx, &call, basetypes.ObjectAsOptions{})...)
+		if call.Params.IsNull() || call.Params.IsUnknown() {
+			continue
+		}
+		var params map[string]any
+		if err := json.Unmarshal([]byte(call.Params.ValueString()), &params); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("calls").AtListIndex(i).AtName("params"), "Invalid call params",
+				fmt.Sprintf("params must be a JSON object: %v", err))
+		}
+	}
+}
+
+// jsonEqual reports whether a and b hold the same JSON value, regardless of
+// formatting and key order.
+func jsonEqual(a, b []byte) bool {
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return bytes.Equal(a, b)
+	}
+	na, _ := json.Marshal(va)
+	nb, _ := json.Marshal(vb)
+	return bytes.Equal(na, nb)
+}
+
+func scheduleJobFromPlan(plan *scheduleResourceModel) scheduleJob {
+	job := scheduleJob{
+		Enable:   plan.Enable.ValueBool(),
+		Timespec: plan.Timespec.ValueString(),
+		Calls:    make([]scheduleCall, 0, len(plan.Calls)),
+	}
+	for _, call := range plan.Calls {
+		c := scheduleCall{Method: call.Method.ValueString()}
+		if !call.Params.IsNull() {
+			c.Params = json.RawMessage(call.Params.ValueString())
+		}
+		job.Calls = append(job.Calls, c)
+	}
+	return job
+}
+
+// readSchedule refreshes state from the device. It returns false if the
+// job no longer exists. Params that only differ in formatting are kept as
+// configured.
+func readSchedule(client *resty.Client, state *scheduleResourceModel) (bool, error) {
+	var result struct {
+		Jobs []scheduleJob `json:"jobs"`
+	}
+	if err := callRPC(client, "Schedule.List", nil, &result); err != nil {
+		return false, err
+	}
+
+	for _, job := range result.Jobs {
+		if job.ID == nil || *job.ID != state.ID.ValueInt32() {
+			continue
+		}
+		calls := make([]scheduleCallModel, 0, len(job.Calls))
+		for i, call := range job.Calls {
+			params := types.StringNull()
+			if len(call.Params) > 0 && string(call.Params) != "null" {
+				params = types.StringValue(string(call.Params))
+				if i < len(state.Calls) && !state.Calls[i].Params.IsNull() &&
+					jsonEqual([]byte(state.Calls[i].Params.ValueString()), call.Params) {
+					params = state.Calls[i].Params
+				}
+			}
+			calls = append(calls, scheduleCallModel{
+				Method: types.StringValue(call.Method),
+				Params: params,
+			})
+		}
+		state.Enable = types.BoolValue(job.Enable)
+		state.Timespec = types.StringValue(job.Timespec)
+		state.Calls = calls
+		return true, nil
+	}
+	return false, nil
+}
+
+func (c *scheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state scheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	found, err := readSchedule(client, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query schedules", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func setSchedule(client *resty.Client, plan *scheduleResourceModel, diags *diag.Diagnostics) error {
+	job := scheduleJobFromPlan(plan)
+	method := "Schedule.Create"
+	if !plan.ID.IsUnknown() && !plan.ID.IsNull() {
+		id := plan.ID.ValueInt32()
+		job.ID = &id
+		method = "Schedule.Update"
+	}
+
+	var result struct {
+		ID *int32 `json:"id"`
+	}
+	if err := callRPC(client, method, job, &result); err != nil {
+		diags.AddError("Failed to set schedule", err.Error())
+		return err
+	}
+	if result.ID != nil {
+		plan.ID = types.Int32Value(*result.ID)
+	}
+	return nil
+}
+
+func (c *scheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan scheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	if err := setSchedule(client, &plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if _, err := readSchedule(client, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query schedules", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *scheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan scheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	if err := setSchedule(client, &plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if _, err := readSchedule(client, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query schedules", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *scheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateIPAndID(ctx, req, resp, "id", "schedule")
+}
+
+func (c *scheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state scheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	if err := callRPC(client, "Schedule.Delete", map[string]any{"id": state.ID.ValueInt32()}, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete schedule", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
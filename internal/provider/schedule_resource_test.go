@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleValidateConfig(t *testing.T) {
+	callType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"method": tftypes.String, "params": tftypes.String}}
+	listType := tftypes.List{ElementType: callType}
+	call := func(params any) tftypes.Value {
+		return tftypes.NewValue(callType, map[string]tftypes.Value{
+			"method": tftypes.NewValue(tftypes.String, "Switch.Set"),
+			"params": tftypes.NewValue(tftypes.String, params),
+		})
+	}
+	for name, tc := range map[string]struct {
+		calls tftypes.Value
+		valid bool
+	}{
+		"unknown":         {tftypes.NewValue(listType, tftypes.UnknownValue), true},
+		"unknown element": {tftypes.NewValue(listType, []tftypes.Value{tftypes.NewValue(callType, tftypes.UnknownValue)}), true},
+		"unknown params":  {tftypes.NewValue(listType, []tftypes.Value{call(tftypes.UnknownValue)}), true},
+		"object params":   {tftypes.NewValue(listType, []tftypes.Value{call(`{"id": 0, "on": true}`)}), true},
+		"invalid params":  {tftypes.NewValue(listType, []tftypes.Value{call(`[0]`)}), false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res := NewScheduleResource()
+			req := resource.ValidateConfigRequest{Config: resourceConfig(t, res, map[string]tftypes.Value{"calls": tc.calls})}
+			var resp resource.ValidateConfigResponse
+			res.(resource.ResourceWithValidateConfig).ValidateConfig(context.Background(), req, &resp)
+			require.Equal(t, !tc.valid, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// scriptVarNameRegexp matches the names accepted in vars, which become
+// JavaScript identifiers.
+var scriptVarNameRegexp = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// scriptSource is one source of a script, with the attribute it comes from.
+type scriptSource struct {
+	path path.Path
+	name string
+	code string
+}
+
+// scriptSources returns code or the contents of the files of plan. known is
+// false if an input is not known yet.
+func scriptSources(ctx context.Context, plan *scriptResourceModel) (sources []scriptSource, known bool, diags diag.Diagnostics) {
+	if plan.Code.IsUnknown() || plan.Files.IsUnknown() {
+		return nil, false, diags
+	}
+	if plan.Files.IsNull() {
+		return []scriptSource{{path: path.Root("code"), name: "code", code: plan.Code.ValueString()}}, true, diags
+	}
+
+	var files []types.String
+	diags.Append(plan.Files.ElementsAs(ctx, &files, false)...)
+	if diags.HasError() {
+		return nil, false, diags
+	}
+	for i, file := range files {
+		if file.IsUnknown() {
+			return nil, false, diags
+		}
+		attribute := path.Root("files").AtListIndex(i)
+		source, err := os.ReadFile(file.ValueString())
+		if err != nil {
+			diags.AddAttributeError(attribute, "Failed to read script source", err.Error())
+			continue
+		}
+		sources = append(sources, scriptSource{path: attribute, name: file.ValueString(), code: string(source)})
+	}
+	return sources, !diags.HasError(), diags
+}
+
+// scriptContent returns the code uploaded for plan: the vars header followed
+// by sources, as returned by scriptSources, minified if requested. known is
+// false if an input is not known yet.
+func scriptContent(ctx context.Context, plan *scriptResourceModel, sources []scriptSource) (content string, known bool, diags diag.Diagnostics) {
+	if plan.Vars.IsUnknown() || plan.Minify.IsUnknown() {
+		return "", false, diags
+	}
+
+	vars := map[string]types.String{}
+	if !plan.Vars.IsNull() {
+		diags.Append(plan.Vars.ElementsAs(ctx, &vars, false)...)
+	}
+	if diags.HasError() {
+		return "", false, diags
+	}
+	values := make(map[string]string, len(vars))
+	for name, value := range vars {
+		if value.IsUnknown() {
+			return "", false, diags
+		}
+		values[name] = value.ValueString()
+	}
+
+	codes := make([]string, 0, len(sources))
+	for _, source := range sources {
+		codes = append(codes, source.code)
+	}
+	return renderScript(values, codes, plan.Minify.ValueBool()), true, diags
+}
+
+// lintScriptSources reports the lint issues of each source against the
+// attribute it comes from.
+func lintScriptSources(sources []scriptSource, diags *diag.Diagnostics) {
+	for _, source := range sources {
+		for _, issue := range lintScript(source.code) {
+			detail := fmt.Sprintf("%s, %s. Set lint to false to skip this check.", source.name, issue)
+			if issue.Warning {
+				diags.AddAttributeWarning(source.path, "Unknown script API", detail)
+			} else {
+				diags.AddAttributeError(source.path, "Unsupported script construct", detail)
+			}
+		}
+	}
+}
+
+// renderScript declares vars as string variables ahead of the sources, and
+// minifies the result if requested.
+func renderScript(vars map[string]string, sources []string, minify bool) string {
+	var b strings.Builder
+	if len(vars) > 0 {
+		names := make([]string, 0, len(vars))
+		for name := range vars {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		b.WriteString("// Variables set by Terraform.\n")
+		for _, name := range names {
+			value, _ := json.Marshal(vars[name])
+			fmt.Fprintf(&b, "let %s = %s;\n", name, value)
+		}
+	}
+	for _, source := range sources {
+		b.WriteString(source)
+		if !strings.HasSuffix(source, "\n") {
+			b.WriteString("\n")
+		}
+	}
+	if minify {
+		return minifyScript(b.String())
+	}
+	return b.String()
+}
+
+// minifyScript strips comments, indentation, trailing whitespace and blank
+// lines from src. Line breaks are kept, since statements may rely on
+// automatic semicolon insertion. String, template and regular expression
+// literals are copied unchanged.
+func minifyScript(src string) string {
+	out := make([]byte, 0, len(src))
+	atLineStart := func() bool {
+		return len(out) == 0 || out[len(out)-1] == '\n'
+	}
+	newline := func() {
+		out = trimTrailingSpace(out)
+		if !atLineStart() {
+			out = append(out, '\n')
+		}
+	}
+
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			newline()
+			i++
+		case (c == ' ' || c == '\t' || c == '\r') && atLineStart():
+			i++
+		case strings.HasPrefix(src[i:], "//"):
+			end := strings.IndexByte(src[i:], '\n')
+			if end < 0 {
+				end = len(src) - i
+			}
+			i += end
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				end = len(src) - i - 2
+			}
+			comment := src[i : i+2+end]
+			i += 2 + end + 2
+			if strings.Contains(comment, "\n") {
+				newline()
+			} else if !atLineStart() && out[len(out)-1] != ' ' {
+				out = append(out, ' ')
+			}
+		case c == '"' || c == '\'' || c == '`':
+			end := literalEnd(src, i, c)
+			out = append(out, src[i:end]...)
+			i = end
+		case c == '/' && regexAllowed(out):
+			end := regexEnd(src, i)
+			out = append(out, src[i:end]...)
+			i = end
+		default:
+			out = append(out, c)
+			i++
+		}
+	}
+	return string(trimTrailingSpace(out))
+}
+
+func trimTrailingSpace(b []byte) []byte {
+	for len(b) > 0 && (b[len(b)-1] == ' ' || b[len(b)-1] == '\t' || b[len(b)-1] == '\r') {
+		b = b[:len(b)-1]
+	}
+	return b
+}
+
+// literalEnd returns the index after the string or template literal that
+// starts at src[start] with quote.
+func literalEnd(src string, start int, quote byte) int {
+	for i := start + 1; i < len(src); i++ {
+		switch src[i] {
+		case '\\':
+			i++
+		case quote:
+			return i + 1
+		case '\n':
+			if quote != '`' {
+				return i
+			}
+		}
+	}
+	return len(src)
+}
+
+// regexEnd returns the index after the regular expression literal that
+// starts at src[start], including its flags.
+func regexEnd(src string, start int) int {
+	inClass := false
+	for i := start + 1; i < len(src); i++ {
+		switch src[i] {
+		case '\\':
+			i++
+		case '[':
+			inClass = true
+		case ']':
+			inClass = false
+		case '\n':
+			return i
+		case '/':
+			if inClass {
+				continue
+			}
+			i++
+			for i < len(src) && isIdentByte(src[i]) {
+				i++
+			}
+			return i
+		}
+	}
+	return len(src)
+}
+
+// regexAllowed reports whether a slash following out starts a regular
+// expression rather than a division.
+func regexAllowed(out []byte) bool {
+	out = trimTrailingSpace(out)
+	if len(out) == 0 {
+		return true
+	}
+	last := out[len(out)-1]
+	if strings.IndexByte("(,=:[!&|?{};+-*%<>~^\n", last) >= 0 {
+		return true
+	}
+	if !isIdentByte(last) {
+		return false
+	}
+	start := len(out)
+	for start > 0 && isIdentByte(out[start-1]) {
+		start--
+	}
+	switch string(out[start:]) {
+	case "return", "typeof", "case", "do", "else", "in", "of", "new", "delete", "void", "throw":
+		return true
+	}
+	return false
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
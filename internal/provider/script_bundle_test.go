@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinifyScript(t *testing.T) {
+	src := `// Turns the switch off after a while.
+let url = "http://example.com/a//b"; // not a comment in the string
+
+/* Block
+   comment */
+function off(delay) {
+    let re = /\/+$/g;    
+    let half = delay / 2;
+    Timer.set(half * 1000, false, function () {
+        Shelly.call("Switch.Set", {id: 0, on: false}); /* inline */ print('/* kept */');
+    });
+    return ` + "`multi\n    line`" + `;
+}
+`
+	want := `let url = "http://example.com/a//b";
+function off(delay) {
+let re = /\/+$/g;
+let half = delay / 2;
+Timer.set(half * 1000, false, function () {
+Shelly.call("Switch.Set", {id: 0, on: false});  print('/* kept */');
+});
+return ` + "`multi\n    line`" + `;
+}
+`
+	require.Equal(t, want, minifyScript(src))
+}
+
+func TestRenderScript(t *testing.T) {
+	got := renderScript(
+		map[string]string{"TOPIC": "garden", "DELAY": `"60"`},
+		[]string{"function helper() {}", "helper();\n"},
+		false,
+	)
+	want := `// Variables set by Terraform.
+let DELAY = "\"60\"";
+let TOPIC = "garden";
+function helper() {}
+helper();
+`
+	require.Equal(t, want, got)
+
+	require.Equal(t, "let A = \"1\";\nprint(A);\n", renderScript(map[string]string{"A": "1"}, []string{"print(A); // done"}, true))
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// shellyScriptAPI lists the methods of the Shelly object available to
+// scripts.
+var shellyScriptAPI = []string{
+	"addEventHandler",
+	"addStatusHandler",
+	"call",
+	"emitEvent",
+	"getComponentConfig",
+	"getComponentStatus",
+	"getCurrentScriptId",
+	"getDeviceInfo",
+	"getUptimeMs",
+	"removeEventHandler",
+	"removeStatusHandler",
+}
+
+// lintIssue is a problem found in a script. Unknown APIs are only warnings,
+// since newer firmware may add them.
+type lintIssue struct {
+	Line    int
+	Message string
+	Warning bool
+}
+
+func (i lintIssue) String() string {
+	return fmt.Sprintf("line %d: %s", i.Line, i.Message)
+}
+
+type lintTokenKind int
+
+const (
+	tokenIdent lintTokenKind = iota
+	tokenPunct
+	tokenNumber
+	tokenString
+	tokenTemplate
+	tokenRegex
+)
+
+type lintToken struct {
+	kind lintTokenKind
+	text string
+	line int
+}
+
+// lintScript reports constructs that the Shelly script runtime does not
+// support, and calls of unknown Shelly.* methods. It works on tokens rather
+// than a full parse, which is enough for the constructs it looks for.
+func lintScript(src string) []lintIssue {
+	tokens, issues := tokenizeScript(src)
+	for i, token := range tokens {
+		var prev, next lintToken
+		if i > 0 {
+			prev = tokens[i-1]
+		}
+		if i+1 < len(tokens) {
+			next = tokens[i+1]
+		}
+		// Property names such as obj.class or {class: 1} are fine.
+		isProperty := prev.kind == tokenPunct && prev.text == "." || next.kind == tokenPunct && next.text == ":"
+
+		switch {
+		case token.kind == tokenIdent && !isProperty:
+			switch token.text {
+			case "class":
+				issues = append(issues, lintIssue{Line: token.line, Message: "classes are not supported"})
+			case "async":
+				if next.kind == tokenIdent || next.text == "(" {
+					issues = append(issues, lintIssue{Line: token.line, Message: "async functions are not supported"})
+				}
+			case "await":
+				issues = append(issues, lintIssue{Line: token.line, Message: "await is not supported"})
+			case "yield":
+				issues = append(issues, lintIssue{Line: token.line, Message: "generators are not supported"})
+			case "function":
+				if next.kind == tokenPunct && next.text == "*" {
+					issues = append(issues, lintIssue{Line: token.line, Message: "generators are not supported"})
+				}
+			case "import", "export":
+				issues = append(issues, lintIssue{Line: token.line, Message: "modules are not supported"})
+			case "Shelly":
+				if i+2 < len(tokens) && next.text == "." && tokens[i+2].kind == tokenIdent && !slices.Contains(shellyScriptAPI, tokens[i+2].text) {
+					issues = append(issues, lintIssue{
+						Line:    token.line,
+						Message: fmt.Sprintf("unknown API Shelly.%s", tokens[i+2].text),
+						Warning: true,
+					})
+				}
+			}
+		case token.kind == tokenPunct && token.text == "?.":
+			issues = append(issues, lintIssue{Line: token.line, Message: "optional chaining (?.) is not supported"})
+		case token.kind == tokenPunct && token.text == "??":
+			issues = append(issues, lintIssue{Line: token.line, Message: "the nullish coalescing operator (??) is not supported"})
+		case token.kind == tokenTemplate && i > 0 && (prev.kind == tokenIdent && !isKeyword(prev.text) || prev.text == ")" || prev.text == "]"):
+			issues = append(issues, lintIssue{Line: token.line, Message: "tagged template literals are not supported"})
+		}
+	}
+	slices.SortStableFunc(issues, func(a, b lintIssue) int { return a.Line - b.Line })
+	return issues
+}
+
+// tokenizeScript splits src into tokens, skipping whitespace and comments.
+// Problems found while scanning literals are returned as issues.
+func tokenizeScript(src string) ([]lintToken, []lintIssue) {
+	var tokens []lintToken
+	var issues []lintIssue
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		start := i
+		switch {
+		case c == '\n':
+			line++
+			i++
+			continue
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+			continue
+		case strings.HasPrefix(src[i:], "//"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			continue
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				issues = append(issues, lintIssue{Line: line, Message: "unterminated comment"})
+				end = len(src) - i - 2
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += 2 + end + 2
+			continue
+		case c == '"' || c == '\'':
+			i = literalEnd(src, i, c)
+			if i-start < 2 || src[i-1] != c {
+				issues = append(issues, lintIssue{Line: line, Message: "unterminated string literal"})
+			}
+			tokens = append(tokens, lintToken{kind: tokenString, text: src[start:i], line: line})
+		case c == '`':
+			end, nested := templateEnd(src, i)
+			if nested {
+				issues = append(issues, lintIssue{Line: line, Message: "nested template literals are not supported"})
+			}
+			i = end
+			tokens = append(tokens, lintToken{kind: tokenTemplate, text: src[start:i], line: line})
+			line += strings.Count(src[start:i], "\n")
+			continue
+		case c == '/' && regexAllowedAfter(tokens):
+			i = regexEnd(src, i)
+			tokens = append(tokens, lintToken{kind: tokenRegex, text: src[start:i], line: line})
+		case isIdentByte(c) && (c < '0' || c > '9'):
+			for i < len(src) && isIdentByte(src[i]) {
+				i++
+			}
+			tokens = append(tokens, lintToken{kind: tokenIdent, text: src[start:i], line: line})
+		case c >= '0' && c <= '9':
+			for i < len(src) && (isIdentByte(src[i]) || src[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, lintToken{kind: tokenNumber, text: src[start:i], line: line})
+		default:
+			i += punctLen(src[i:])
+			tokens = append(tokens, lintToken{kind: tokenPunct, text: src[start:i], line: line})
+		}
+	}
+	return tokens, issues
+}
+
+// templateEnd returns the index after the template literal that starts at
+// src[start], and whether one of its substitutions contains another
+// template literal.
+func templateEnd(src string, start int) (int, bool) {
+	depth := 0
+	nested := false
+	for i := start + 1; i < len(src); i++ {
+		switch {
+		case src[i] == '\\':
+			i++
+		case depth == 0 && src[i] == '`':
+			return i + 1, nested
+		case depth == 0 && strings.HasPrefix(src[i:], "${"):
+			depth = 1
+			i++
+		case depth > 0 && src[i] == '{':
+			depth++
+		case depth > 0 && src[i] == '}':
+			depth--
+		case depth > 0 && src[i] == '`':
+			nested = true
+			end, _ := templateEnd(src, i)
+			i = end - 1
+		case depth > 0 && (src[i] == '"' || src[i] == '\''):
+			i = literalEnd(src, i, src[i]) - 1
+		}
+	}
+	return len(src), nested
+}
+
+// punctLen returns the length of the punctuator at the start of s.
+func punctLen(s string) int {
+	for _, p := range []string{"...", "===", "!==", "**=", "<<=", ">>=", ">>>", "=>", "==", "!=", "<=", ">=", "&&", "||", "++", "--", "+=", "-=", "*=", "/=", "%=", "&=", "|=", "^=", "<<", ">>", "**", "??"} {
+		if strings.HasPrefix(s, p) {
+			return len(p)
+		}
+	}
+	// a?.b is optional chaining, but a?.5:1 is a conditional.
+	if strings.HasPrefix(s, "?.") && (len(s) < 3 || s[2] < '0' || s[2] > '9') {
+		return 2
+	}
+	return 1
+}
+
+// regexAllowedAfter reports whether a slash following tokens starts a
+// regular expression rather than a division.
+func regexAllowedAfter(tokens []lintToken) bool {
+	if len(tokens) == 0 {
+		return true
+	}
+	last := tokens[len(tokens)-1]
+	switch last.kind {
+	case tokenIdent:
+		return isKeyword(last.text)
+	case tokenPunct:
+		return last.text != ")" && last.text != "]" && last.text != "}"
+	}
+	return false
+}
+
+func isKeyword(word string) bool {
+	switch word {
+	case "return", "typeof", "case", "do", "else", "in", "of", "new", "delete", "void", "throw", "instanceof":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintScript(t *testing.T) {
+	src := `// class async await in a comment are fine
+let config = {class: "a", url: "http://x/?.y"};
+print(config.class, 10 / 2, /\?\?/.test("??"));
+Shelly.call("Switch.Set", {id: 0, on: true});
+Shelly.getUptimeMs();
+Shelly.reboot();
+class Timer2 {}
+async function f() {
+  await g();
+}
+let v = config?.url ?? "none";
+let s = html` + "`<b>${v}</b>`" + `;
+let n = ` + "`${v ? `a` : \"b\"}`" + `;
+/* multi
+   line */ let ok = cond ?.5 : 1;
+`
+	var got []string
+	var warnings []string
+	for _, issue := range lintScript(src) {
+		if issue.Warning {
+			warnings = append(warnings, issue.String())
+		} else {
+			got = append(got, issue.String())
+		}
+	}
+	require.Equal(t, []string{
+		"line 7: classes are not supported",
+		"line 8: async functions are not supported",
+		"line 9: await is not supported",
+		"line 11: optional chaining (?.) is not supported",
+		"line 11: the nullish coalescing operator (??) is not supported",
+		"line 12: tagged template literals are not supported",
+		"line 13: nested template literals are not supported",
+	}, got)
+	require.Equal(t, []string{"line 6: unknown API Shelly.reboot"}, warnings)
+}
+
+func TestLintScriptUnterminated(t *testing.T) {
+	issues := lintScript("let a = 1;\nlet b = \"oops;\n")
+	require.Len(t, issues, 1)
+	require.Equal(t, "line 2: unterminated string literal", issues[0].String())
+}
@@ -0,0 +1,601 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"resty.dev/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &scriptResource{}
+	_ resource.ResourceWithConfigure      = &scriptResource{}
+	_ resource.ResourceWithImportState    = &scriptResource{}
+	_ resource.ResourceWithModifyPlan     = &scriptResource{}
+	_ resource.ResourceWithValidateConfig = &scriptResource{}
+)
+
+const (
+	// scriptChunkSize is the size of the code chunks sent with Script.PutCode,
+	// which keeps each request well below the device's request size limit.
+	scriptChunkSize = 1024
+	// rpcErrInvalidArgument is the code the device reports for a component
+	// ID that does not exist, among other invalid arguments.
+	rpcErrInvalidArgument = -105
+	// defaultScriptGracePeriod is how long a script must keep running after
+	// it was started, unless configured otherwise.
+	defaultScriptGracePeriod = 5
+)
+
+func NewScriptResource() resource.Resource {
+	return &scriptResource{}
+}
+
+type scriptResourceModel struct {
+	IP                 types.String `tfsdk:"ip"`
+	ID                 types.Int32  `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Enable             types.Bool   `tfsdk:"enable"`
+	Code               types.String `tfsdk:"code"`
+	Files              types.List   `tfsdk:"files"`
+	Vars               types.Map    `tfsdk:"vars"`
+	Minify             types.Bool   `tfsdk:"minify"`
+	Lint               types.Bool   `tfsdk:"lint"`
+	Start              types.Bool   `tfsdk:"start"`
+	RequireRunning     types.Bool   `tfsdk:"require_running"`
+	RunningGracePeriod types.Int64  `tfsdk:"running_grace_period"`
+	CodeSHA256         types.String `tfsdk:"code_sha256"`
+	CodeSize           types.Int64  `tfsdk:"code_size"`
+}
+
+// scriptConfig mirrors the config object of Script.GetConfig /
+// Script.SetConfig.
+type scriptConfig struct {
+	ID     int     `json:"id"`
+	Name   *string `json:"name,omitempty"`
+	Enable *bool   `json:"enable,omitempty"`
+}
+
+// scriptStatus is the result of Script.GetStatus.
+type scriptStatus struct {
+	ID       int      `json:"id"`
+	Running  bool     `json:"running"`
+	MemUsed  *int64   `json:"mem_used"`
+	MemPeak  *int64   `json:"mem_peak"`
+	MemFree  *int64   `json:"mem_free"`
+	Errors   []string `json:"errors"`
+	ErrorMsg *string  `json:"error_msg"`
+}
+
+type scriptResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *scriptResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_script"
+}
+
+func (c *scriptResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *scriptResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a script on a Shelly device: its slot, code, name and whether it runs on boot.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the script slot, assigned by the device.",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the script.",
+			},
+			"enable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "True if the script runs when the device boots. Defaults to false.",
+			},
+			"code": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Source code of the script, e.g. `file(\"${path.module}/script.js\")`. Exactly one of `code` and `files` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("files")),
+				},
+			},
+			"files": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Paths of source files, e.g. shared helpers followed by the main script, uploaded concatenated in this order. The files are read when planning, so changes to them show up in the plan.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"vars": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Values declared as string variables ahead of the code, e.g. `{ TOPIC = \"garden\" }` becomes `let TOPIC = \"garden\";`.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(scriptVarNameRegexp, "must be a JavaScript identifier")),
+				},
+			},
+			"minify": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Strip comments, indentation and blank lines before uploading, to save device memory. Defaults to false.",
+			},
+			"lint": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Check the sources when planning for constructs the Shelly script runtime does not support, such as classes, async functions or optional chaining, and warn about unknown `Shelly.*` methods. Defaults to true.",
+			},
+			"start": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Start the script after uploading its code, restarting it if it was running. Defaults to false.",
+			},
+			"require_running": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Fail the apply if the started script reports errors or stops within `running_grace_period`. Requires `start`. Defaults to false.",
+			},
+			"running_grace_period": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultScriptGracePeriod),
+				MarkdownDescription: fmt.Sprintf("Seconds the script must keep running after it was started when `require_running` is set. Defaults to %d.", defaultScriptGracePeriod),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 300),
+				},
+			},
+			"code_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 hash of the code on the device. It differs from the hash of the rendered code when the script was changed outside Terraform.",
+			},
+			"code_size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Size in bytes of the code on the device. When planning, the rendered code is checked against the free file system space of the device (`fs_free`), and against its free system RAM (`ram_free`) if the script is started or enabled. Devices do not report how much memory is left for scripts, so a script that passes the RAM check can still fail to start.",
+			},
+		},
+	}
+}
+
+func (c *scriptResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var start, requireRunning types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("start"), &start)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("require_running"), &requireRunning)...)
+	if resp.Diagnostics.HasError() || start.IsUnknown() || requireRunning.IsUnknown() {
+		return
+	}
+	if requireRunning.ValueBool() && !start.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("require_running"), "Invalid attribute combination",
+			"require_running needs start to be true.")
+	}
+}
+
+func scriptCodeHash(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *scriptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan scriptResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sources, known, diags := scriptSources(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if plan.Lint.ValueBool() {
+		lintScriptSources(sources, &resp.Diagnostics)
+	}
+	var content string
+	if known {
+		content, known, diags = scriptContent(ctx, &plan, sources)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !known {
+		plan.CodeSHA256 = types.StringUnknown()
+		plan.CodeSize = types.Int64Unknown()
+	} else {
+		plan.CodeSHA256 = types.StringValue(scriptCodeHash(content))
+		plan.CodeSize = types.Int64Value(int64(len(content)))
+		// The size of the code being replaced is known from state.
+		var currentSize types.Int64
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("code_size"), &currentSize)...)
+		}
+		checkScriptSize(c.credentials, &plan, currentSize.ValueInt64(), len(content), &resp.Diagnostics)
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// checkScriptSize checks that code of size bytes fits into the free file
+// system space of the device, counting the currentSize bytes of the code it
+// replaces, and into its free system RAM if the script is going to run.
+// Devices do not report the memory left for scripts, so ram_free of
+// Sys.GetStatus is the closest figure. The memory of the script being
+// replaced is counted as free, since it is stopped first. Devices that
+// cannot be reached are not checked.
+func checkScriptSize(credentials *deviceCredentials, plan *scriptResourceModel, currentSize int64, size int, diags *diag.Diagnostics) {
+	if plan.IP.IsUnknown() {
+		return
+	}
+	client := newDeviceClient(credentials, plan.IP.ValueString())
+	defer client.Close()
+	client.SetTimeout(deviceRequestTimeout)
+
+	var status struct {
+		FSFree  *int64 `json:"fs_free"`
+		RAMFree *int64 `json:"ram_free"`
+	}
+	if err := callRPC(client, "Sys.GetStatus", nil, &status); err != nil {
+		return
+	}
+	if status.FSFree != nil && int64(size) > *status.FSFree+currentSize {
+		diags.AddError("Script too large",
+			fmt.Sprintf("The script is %d bytes, but the device at %s only has %d bytes of free file system space. Consider setting minify.",
+				size, plan.IP.ValueString(), *status.FSFree+currentSize))
+		return
+	}
+
+	runs := plan.Start.ValueBool() || plan.Enable.ValueBool()
+	if !runs || status.RAMFree == nil {
+		return
+	}
+	available := *status.RAMFree
+	if !plan.ID.IsUnknown() && !plan.ID.IsNull() {
+		if current, err := getScriptStatus(client, plan.ID.ValueInt32()); err == nil && current.Running && current.MemUsed != nil {
+			available += *current.MemUsed
+		}
+	}
+	if int64(size) > available {
+		diags.AddError("Script does not fit into free RAM",
+			fmt.Sprintf("The script is %d bytes, but the device at %s only has %d bytes of free system RAM. Consider setting minify.",
+				size, plan.IP.ValueString(), available))
+	}
+}
+
+// isNotFound reports whether err is the device rejecting an unknown
+// component ID.
+func isNotFound(err error) bool {
+	var rpcErr *rpcError
+	return errors.As(err, &rpcErr) && rpcErr.Code == rpcErrInvalidArgument
+}
+
+// getScriptCode reads the whole code of script id, which the device returns
+// in pieces.
+func getScriptCode(client *resty.Client, id int32) (string, error) {
+	var code []byte
+	for {
+		var result struct {
+			Data string `json:"data"`
+			Left int    `json:"left"`
+		}
+		params := map[string]any{"id": id, "offset": len(code)}
+		if err := callRPC(client, "Script.GetCode", params, &result); err != nil {
+			return "", err
+		}
+		code = append(code, result.Data...)
+		if result.Left == 0 || result.Data == "" {
+			return string(code), nil
+		}
+	}
+}
+
+// putScriptCode uploads code to script id in chunks of at most
+// scriptChunkSize bytes, split on character boundaries.
+func putScriptCode(client *resty.Client, id int32, code string) error {
+	appendChunk := false
+	for {
+		chunk := code
+		if len(chunk) > scriptChunkSize {
+			end := scriptChunkSize
+			for end > 0 && !utf8.RuneStart(code[end]) {
+				end--
+			}
+			chunk = code[:end]
+		}
+		params := map[string]any{"id": id, "code": chunk, "append": appendChunk}
+		if err := callRPC(client, "Script.PutCode", params, nil); err != nil {
+			return err
+		}
+		code = code[len(chunk):]
+		appendChunk = true
+		if code == "" {
+			return nil
+		}
+	}
+}
+
+// readScript refreshes state from the device. It returns false if the
+// script no longer exists.
+func readScript(client *resty.Client, state *scriptResourceModel) (bool, error) {
+	var config scriptConfig
+	if err := callRPC(client, "Script.GetConfig", map[string]any{"id": state.ID.ValueInt32()}, &config); err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	code, err := getScriptCode(client, state.ID.ValueInt32())
+	if err != nil {
+		return false, err
+	}
+
+	state.Name = types.StringPointerValue(config.Name)
+	state.Enable = types.BoolPointerValue(config.Enable)
+	state.CodeSHA256 = types.StringValue(scriptCodeHash(code))
+	state.CodeSize = types.Int64Value(int64(len(code)))
+	return true, nil
+}
+
+func getScriptStatus(client *resty.Client, id int32) (*scriptStatus, error) {
+	var status scriptStatus
+	if err := callRPC(client, "Script.GetStatus", map[string]any{"id": id}, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// scriptFailure describes why the script with status is not running, or
+// returns "" if it is.
+func scriptFailure(status *scriptStatus) string {
+	var reasons []string
+	if len(status.Errors) > 0 {
+		reasons = append(reasons, "errors: "+strings.Join(status.Errors, ", "))
+	}
+	if status.ErrorMsg != nil && *status.ErrorMsg != "" {
+		reasons = append(reasons, *status.ErrorMsg)
+	}
+	if len(reasons) == 0 && status.Running {
+		return ""
+	}
+	if len(reasons) == 0 {
+		reasons = append(reasons, "no error reported")
+	}
+	state := "stopped"
+	if status.Running {
+		state = "running"
+	}
+	return fmt.Sprintf("script is %s (%s)", state, strings.Join(reasons, "; "))
+}
+
+// checkScriptRunning polls the status of script id for grace and fails as
+// soon as the script reports errors or stops.
+func checkScriptRunning(ctx context.Context, client *resty.Client, id int32, grace time.Duration) error {
+	deadline := time.Now().Add(grace)
+	for {
+		status, err := getScriptStatus(client, id)
+		if err != nil {
+			return err
+		}
+		if failure := scriptFailure(status); failure != "" {
+			return errors.New(failure)
+		}
+		if time.Now().After(deadline) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// setScript applies plan to the script slot in plan.ID: it stops the script
+// if it is running, uploads the code and config, and starts it if requested.
+func setScript(ctx context.Context, client *resty.Client, plan *scriptResourceModel, diags *diag.Diagnostics) error {
+	id := plan.ID.ValueInt32()
+
+	sources, _, d := scriptSources(ctx, plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		return errInvalidPlan
+	}
+	content, _, d := scriptContent(ctx, plan, sources)
+	diags.Append(d...)
+	if diags.HasError() {
+		return errInvalidPlan
+	}
+
+	status, err := getScriptStatus(client, id)
+	if err != nil {
+		diags.AddError("Failed to query script status", err.Error())
+		return err
+	}
+	if status.Running {
+		if err := callRPC(client, "Script.Stop", map[string]any{"id": id}, nil); err != nil {
+			diags.AddError("Failed to stop script", err.Error())
+			return err
+		}
+	}
+
+	if err := putScriptCode(client, id, content); err != nil {
+		diags.AddError("Failed to upload script code", err.Error())
+		return err
+	}
+
+	config := scriptConfig{
+		ID:     int(id),
+		Name:   stringPointer(plan.Name),
+		Enable: boolPointer(plan.Enable),
+	}
+	if err := callRPC(client, "Script.SetConfig", map[string]any{"id": id, "config": config}, nil); err != nil {
+		diags.AddError("Failed to set script config", err.Error())
+		return err
+	}
+
+	if plan.Start.ValueBool() {
+		if err := callRPC(client, "Script.Start", map[string]any{"id": id}, nil); err != nil {
+			diags.AddError("Failed to start script", err.Error())
+			return err
+		}
+	}
+	if plan.Start.ValueBool() && plan.RequireRunning.ValueBool() {
+		grace := time.Duration(plan.RunningGracePeriod.ValueInt64()) * time.Second
+		if err := checkScriptRunning(ctx, client, id, grace); err != nil {
+			diags.AddError("Script is not running", err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *scriptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state scriptResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	found, err := readScript(client, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query script", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *scriptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan scriptResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	var created struct {
+		ID int32 `json:"id"`
+	}
+	if err := callRPC(client, "Script.Create", map[string]any{"name": plan.Name.ValueString()}, &created); err != nil {
+		resp.Diagnostics.AddError("Failed to create script", err.Error())
+		return
+	}
+	plan.ID = types.Int32Value(created.ID)
+	// Record the slot right away so that it is not leaked if the upload fails.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	if err := setScript(ctx, client, &plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if _, err := readScript(client, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query script", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *scriptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan scriptResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	if err := setScript(ctx, client, &plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if _, err := readScript(client, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query script", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *scriptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateIPAndID(ctx, req, resp, "id", "script")
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("minify"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("lint"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("start"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("require_running"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("running_grace_period"), defaultScriptGracePeriod)...)
+}
+
+func (c *scriptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state scriptResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	// A running script cannot be deleted. Stopping one that is not running
+	// or already gone is harmless.
+	_ = callRPC(client, "Script.Stop", map[string]any{"id": state.ID.ValueInt32()}, nil)
+	if err := callRPC(client, "Script.Delete", map[string]any{"id": state.ID.ValueInt32()}, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete script", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeScriptDevice answers Script.PutCode and Script.GetCode for a single
+// script, serving the code back in small pieces.
+type fakeScriptDevice struct {
+	code   string
+	chunks []string
+}
+
+func (d *fakeScriptDevice) handle(method string, raw json.RawMessage) (any, *rpcError) {
+	var params struct {
+		Code   string `json:"code"`
+		Append bool   `json:"append"`
+		Offset int    `json:"offset"`
+	}
+	_ = json.Unmarshal(raw, &params)
+
+	switch method {
+	case "Script.PutCode":
+		d.chunks = append(d.chunks, params.Code)
+		if !params.Append {
+			d.code = ""
+		}
+		d.code += params.Code
+		return map[string]any{"len": len(d.code)}, nil
+	case "Script.GetCode":
+		data := d.code[params.Offset:]
+		if len(data) > 100 {
+			end := 100
+			for !utf8.RuneStart(data[end]) {
+				end--
+			}
+			data = data[:end]
+		}
+		return map[string]any{"data": data, "left": len(d.code) - params.Offset - len(data)}, nil
+	}
+	return nil, nil
+}
+
+func TestPutAndGetScriptCode(t *testing.T) {
+	device := &fakeScriptDevice{code: "stale"}
+	client := newFakeDevice(t, device.handle)
+
+	// Multi-byte characters straddle the chunk boundaries.
+	code := strings.Repeat("print('°');\n", scriptChunkSize/4)
+	require.NoError(t, putScriptCode(client, 1, code))
+	require.Equal(t, code, device.code)
+	require.Greater(t, len(device.chunks), 1)
+	for _, chunk := range device.chunks {
+		require.LessOrEqual(t, len(chunk), scriptChunkSize)
+	}
+
+	got, err := getScriptCode(client, 1)
+	require.NoError(t, err)
+	require.Equal(t, code, got)
+	require.Equal(t, scriptCodeHash(code), scriptCodeHash(got))
+}
+
+func TestCheckScriptSize(t *testing.T) {
+	var getCodeCalls int
+	client := newFakeDevice(t, func(method string, _ json.RawMessage) (any, *rpcError) {
+		switch method {
+		case "Sys.GetStatus":
+			return map[string]any{"fs_free": 4000, "ram_free": 3000}, nil
+		case "Script.GetStatus":
+			return map[string]any{"id": 1, "running": true, "mem_used": 1500}, nil
+		case "Script.GetCode":
+			getCodeCalls++
+		}
+		return nil, nil
+	})
+	ip := types.StringValue(strings.TrimPrefix(client.BaseURL(), "http://"))
+
+	for name, tc := range map[string]struct {
+		plan        scriptResourceModel
+		currentSize int64
+		size        int
+		valid       bool
+	}{
+		"fits":                  {scriptResourceModel{IP: ip, ID: types.Int32Unknown(), Start: types.BoolValue(true)}, 0, 2000, true},
+		"exceeds storage":       {scriptResourceModel{IP: ip, ID: types.Int32Unknown()}, 0, 5000, false},
+		"replaces current code": {scriptResourceModel{IP: ip, ID: types.Int32Value(1)}, 2000, 5000, true},
+		"exceeds memory":        {scriptResourceModel{IP: ip, ID: types.Int32Unknown(), Start: types.BoolValue(true)}, 0, 3500, false},
+		"not started":           {scriptResourceModel{IP: ip, ID: types.Int32Unknown()}, 0, 3500, true},
+		"replaces running":      {scriptResourceModel{IP: ip, ID: types.Int32Value(1), Enable: types.BoolValue(true)}, 1000, 4000, true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			checkScriptSize(nil, &tc.plan, tc.currentSize, tc.size, &diags)
+			require.Equal(t, !tc.valid, diags.HasError(), diags)
+		})
+	}
+	require.Zero(t, getCodeCalls)
+}
+
+func TestScriptModifyPlanReadsSourcesOnce(t *testing.T) {
+	ctx := context.Background()
+	res := NewScriptResource()
+	config := resourceConfig(t, res, map[string]tftypes.Value{
+		"ip":     tftypes.NewValue(tftypes.String, "192.0.2.1"),
+		"name":   tftypes.NewValue(tftypes.String, "motion"),
+		"files":  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "missing.js"))}),
+		"lint":   tftypes.NewValue(tftypes.Bool, true),
+		"minify": tftypes.NewValue(tftypes.Bool, false),
+	})
+	req := resource.ModifyPlanRequest{
+		Config: config,
+		Plan:   tfsdk.Plan{Schema: config.Schema, Raw: config.Raw},
+		State:  tfsdk.State{Schema: config.Schema, Raw: tftypes.NewValue(config.Raw.Type(), nil)},
+	}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	res.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, req, &resp)
+	require.Equal(t, 1, resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+	require.Equal(t, "Failed to read script source", resp.Diagnostics.Errors()[0].Summary())
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &scriptStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &scriptStatusDataSource{}
+)
+
+func NewScriptStatusDataSource() datasource.DataSource {
+	return &scriptStatusDataSource{}
+}
+
+type scriptStatusDataSource struct {
+	credentials *deviceCredentials
+}
+
+type scriptStatusModel struct {
+	IP       types.String `tfsdk:"ip"`
+	ID       types.Int32  `tfsdk:"id"`
+	Running  types.Bool   `tfsdk:"running"`
+	MemUsed  types.Int64  `tfsdk:"mem_used"`
+	MemPeak  types.Int64  `tfsdk:"mem_peak"`
+	MemFree  types.Int64  `tfsdk:"mem_free"`
+	Errors   types.List   `tfsdk:"errors"`
+	ErrorMsg types.String `tfsdk:"error_msg"`
+}
+
+func (d *scriptStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_script_status"
+}
+
+func (d *scriptStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (d *scriptStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Queries the runtime status of a script, e.g. to check that it did not crash.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the device.",
+			},
+			"id": schema.Int32Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the script, e.g. `shelly_script.example.id`.",
+			},
+			"running": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "True if the script is running.",
+			},
+			"mem_used": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Memory used by the script in bytes, null if it is not running.",
+			},
+			"mem_peak": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Peak memory used by the script in bytes, null if it is not running.",
+			},
+			"mem_free": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Memory still available to scripts in bytes, null if the script is not running.",
+			},
+			"errors": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Errors reported for the script, e.g. `crashed` or `syntax_error`.",
+			},
+			"error_msg": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Message of the last error, if the firmware reports one.",
+			},
+		},
+	}
+}
+
+func (d *scriptStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data scriptStatusModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(d.credentials, data.IP.ValueString())
+	defer client.Close()
+
+	status, err := getScriptStatus(client, data.ID.ValueInt32())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query script status", err.Error())
+		return
+	}
+
+	errs := status.Errors
+	if errs == nil {
+		errs = []string{}
+	}
+	data.Running = types.BoolValue(status.Running)
+	data.MemUsed = types.Int64PointerValue(status.MemUsed)
+	data.MemPeak = types.Int64PointerValue(status.MemPeak)
+	data.MemFree = types.Int64PointerValue(status.MemFree)
+	data.ErrorMsg = types.StringPointerValue(status.ErrorMsg)
+	data.Errors, diags = types.ListValueFrom(ctx, types.StringType, errs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &sensorAddonOneWireDevicesDataSource{}
+	_ datasource.DataSourceWithConfigure = &sensorAddonOneWireDevicesDataSource{}
+)
+
+func NewSensorAddonOneWireDevicesDataSource() datasource.DataSource {
+	return &sensorAddonOneWireDevicesDataSource{}
+}
+
+type sensorAddonOneWireDevicesDataSource struct {
+	credentials *deviceCredentials
+}
+
+type sensorAddonOneWireDevicesModel struct {
+	IP      types.String         `tfsdk:"ip"`
+	Devices []oneWireDeviceModel `tfsdk:"devices"`
+}
+
+type oneWireDeviceModel struct {
+	Type      types.String `tfsdk:"type"`
+	Addr      types.String `tfsdk:"addr"`
+	Component types.String `tfsdk:"component"`
+}
+
+// oneWireScanResult is the result of SensorAddon.OneWireScan.
+type oneWireScanResult struct {
+	Devices []struct {
+		Type      string  `json:"type"`
+		Addr      string  `json:"addr"`
+		Component *string `json:"component"`
+	} `json:"devices"`
+}
+
+func (d *sensorAddonOneWireDevicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sensor_addon_onewire_devices"
+}
+
+func (d *sensorAddonOneWireDevicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (d *sensorAddonOneWireDevicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Scans the 1-Wire bus of a Plus Sensor Add-on for attached DS18B20 sensors.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the device.",
+			},
+			"devices": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The sensors found on the bus.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the sensor, e.g. `ds18b20`.",
+						},
+						"addr": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "1-Wire address of the sensor.",
+						},
+						"component": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Component the sensor is assigned to, e.g. `temperature:100`, or null if it has not been added as a peripheral.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sensorAddonOneWireDevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sensorAddonOneWireDevicesModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(d.credentials, data.IP.ValueString())
+	defer client.Close()
+
+	var result oneWireScanResult
+	if err := callRPC(client, "SensorAddon.OneWireScan", nil, &result); err != nil {
+		resp.Diagnostics.AddError("Failed to scan the 1-Wire bus", err.Error())
+		return
+	}
+
+	data.Devices = make([]oneWireDeviceModel, 0, len(result.Devices))
+	for _, device := range result.Devices {
+		data.Devices = append(data.Devices, oneWireDeviceModel{
+			Type:      types.StringValue(device.Type),
+			Addr:      types.StringValue(device.Addr),
+			Component: types.StringPointerValue(device.Component),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
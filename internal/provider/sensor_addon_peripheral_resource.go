@@ -0,0 +1,389 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"resty.dev/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &sensorAddonPeripheralResource{}
+	_ resource.ResourceWithConfigure      = &sensorAddonPeripheralResource{}
+	_ resource.ResourceWithImportState    = &sensorAddonPeripheralResource{}
+	_ resource.ResourceWithValidateConfig = &sensorAddonPeripheralResource{}
+)
+
+// sensorAddonPeripheralTypes are the peripheral types supported by the Plus
+// Sensor Add-on.
+var sensorAddonPeripheralTypes = []string{"ds18b20", "dht22", "digital_in", "analog_in", "voltmeter"}
+
+func NewSensorAddonPeripheralResource() resource.Resource {
+	return &sensorAddonPeripheralResource{}
+}
+
+type sensorAddonPeripheralResourceModel struct {
+	IP         types.String `tfsdk:"ip"`
+	Type       types.String `tfsdk:"type"`
+	CID        types.Int32  `tfsdk:"cid"`
+	Addr       types.String `tfsdk:"addr"`
+	Components types.List   `tfsdk:"components"`
+	Reboot     types.Bool   `tfsdk:"reboot"`
+}
+
+// sensorAddonPeripheralAttrs are the attrs of SensorAddon.AddPeripheral and
+// the per-component entries of SensorAddon.GetPeripherals.
+type sensorAddonPeripheralAttrs struct {
+	CID  *int32  `json:"cid,omitempty"`
+	Addr *string `json:"addr,omitempty"`
+}
+
+// sensorAddonPeripherals is the result of SensorAddon.GetPeripherals: the
+// components of each peripheral type, keyed by component (e.g.
+// temperature:100).
+type sensorAddonPeripherals map[string]map[string]sensorAddonPeripheralAttrs
+
+type sensorAddonPeripheralResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *sensorAddonPeripheralResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sensor_addon_peripheral"
+}
+
+func (c *sensorAddonPeripheralResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *sensorAddonPeripheralResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a peripheral to the Plus Sensor Add-on of a device. The peripheral's components can then be configured with the sensor config resources.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Type of the peripheral. Range of values: " + strings.Join(sensorAddonPeripheralTypes, ", ") + ".",
+				Validators: []validator.String{
+					stringvalidator.OneOf(sensorAddonPeripheralTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cid": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ID of the component created for the peripheral, 100 or above. Chosen by the device if not set.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(100),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+					int32planmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"addr": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "1-Wire address of a `ds18b20` sensor, as reported by the `shelly_sensor_addon_onewire_devices` data source. Chosen by the device if not set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"components": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Components created for the peripheral, e.g. `[\"temperature:100\"]`. A `dht22` creates a temperature and a humidity component.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reboot": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Reboot the device after adding or removing the peripheral, which is needed for the change to take effect. Defaults to true.",
+			},
+		},
+	}
+}
+
+func (c *sensorAddonPeripheralResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config sensorAddonPeripheralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Type.IsUnknown() {
+		return
+	}
+	if !config.Addr.IsNull() && config.Type.ValueString() != "ds18b20" {
+		resp.Diagnostics.AddAttributeError(path.Root("addr"), "Invalid attribute",
+			"addr can only be set for ds18b20 peripherals.")
+	}
+}
+
+// componentCID returns the numeric ID of a component key such as
+// temperature:100.
+func componentCID(component string) (int32, error) {
+	_, id, ok := strings.Cut(component, ":")
+	if !ok {
+		return 0, fmt.Errorf("invalid component %q", component)
+	}
+	cid, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid component %q: %w", component, err)
+	}
+	return int32(cid), nil
+}
+
+func getSensorAddonPeripherals(client *resty.Client) (sensorAddonPeripherals, error) {
+	var peripherals sensorAddonPeripherals
+	if err := callRPC(client, "SensorAddon.GetPeripherals", nil, &peripherals); err != nil {
+		return nil, err
+	}
+	return peripherals, nil
+}
+
+// readSensorAddonPeripheral looks up the peripheral that owns component and
+// refreshes state from it. It returns false if the peripheral is gone.
+func readSensorAddonPeripheral(ctx context.Context, credentials *deviceCredentials, state *sensorAddonPeripheralResourceModel, component string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	client := newDeviceClient(credentials, state.IP.ValueString())
+	defer client.Close()
+
+	peripherals, err := getSensorAddonPeripherals(client)
+	if err != nil {
+		diags.AddError("Failed to query Sensor Add-on peripherals", err.Error())
+		return false, diags
+	}
+	cid, err := componentCID(component)
+	if err != nil {
+		diags.AddError("Failed to query Sensor Add-on peripherals", err.Error())
+		return false, diags
+	}
+
+	for _, peripheralType := range sensorAddonPeripheralTypes {
+		entries := peripherals[peripheralType]
+		if _, ok := entries[component]; !ok {
+			continue
+		}
+		// A peripheral's components share their ID, e.g. the temperature
+		// and humidity components of a DHT22.
+		var components []string
+		for key, attrs := range entries {
+			if id, err := componentCID(key); err == nil && id == cid {
+				components = append(components, key)
+				if attrs.Addr != nil {
+					state.Addr = types.StringPointerValue(attrs.Addr)
+				}
+			}
+		}
+		slices.Sort(components)
+
+		state.Type = types.StringValue(peripheralType)
+		state.CID = types.Int32Value(cid)
+		if state.Addr.IsUnknown() {
+			state.Addr = types.StringNull()
+		}
+		var d diag.Diagnostics
+		state.Components, d = types.ListValueFrom(ctx, types.StringType, components)
+		diags.Append(d...)
+		return true, diags
+	}
+	return false, diags
+}
+
+// firstComponent returns the first component recorded in state.
+func firstComponent(ctx context.Context, state *sensorAddonPeripheralResourceModel) (string, diag.Diagnostics) {
+	var components []string
+	diags := state.Components.ElementsAs(ctx, &components, false)
+	if diags.HasError() || len(components) == 0 {
+		diags.AddError("Invalid state", "The peripheral has no components recorded.")
+		return "", diags
+	}
+	return components[0], diags
+}
+
+func (c *sensorAddonPeripheralResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sensorAddonPeripheralResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	component, diags := firstComponent(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	found, diags := readSensorAddonPeripheral(ctx, c.credentials, &state, component)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *sensorAddonPeripheralResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sensorAddonPeripheralResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	params := map[string]any{"type": plan.Type.ValueString()}
+	attrs := sensorAddonPeripheralAttrs{Addr: stringPointer(plan.Addr)}
+	if !plan.CID.IsNull() && !plan.CID.IsUnknown() {
+		cid := plan.CID.ValueInt32()
+		attrs.CID = &cid
+	}
+	if attrs != (sensorAddonPeripheralAttrs{}) {
+		params["attrs"] = attrs
+	}
+	var added map[string]json.RawMessage
+	if err := callRPC(client, "SensorAddon.AddPeripheral", params, &added); err != nil {
+		resp.Diagnostics.AddError("Failed to add Sensor Add-on peripheral", err.Error())
+		return
+	}
+	if len(added) == 0 {
+		resp.Diagnostics.AddError("Failed to add Sensor Add-on peripheral", "The device did not report the created component.")
+		return
+	}
+	components := slices.Sorted(maps.Keys(added))
+	component := components[0]
+	cid, err := componentCID(component)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add Sensor Add-on peripheral", err.Error())
+		return
+	}
+	plan.CID = types.Int32Value(cid)
+	if plan.Addr.IsUnknown() {
+		plan.Addr = types.StringNull()
+	}
+	plan.Components, diags = types.ListValueFrom(ctx, types.StringType, components)
+	resp.Diagnostics.Append(diags...)
+	// Record the peripheral right away so that it is not leaked if the reboot
+	// or the read-back fails.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Reboot.ValueBool() {
+		if err := rebootDevice(ctx, c.credentials, plan.IP.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Failed to reboot device", err.Error())
+			return
+		}
+	} else {
+		resp.Diagnostics.AddWarning("Reboot required", "The peripheral is available after the device is rebooted.")
+	}
+
+	found, diags := readSensorAddonPeripheral(ctx, c.credentials, &plan, component)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Failed to add Sensor Add-on peripheral",
+			fmt.Sprintf("The device reported %s as added, but does not list it.", component))
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *sensorAddonPeripheralResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Everything but reboot requires replacement, so there is nothing to apply.
+	var plan sensorAddonPeripheralResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *sensorAddonPeripheralResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ip, component, ok := strings.Cut(req.ID, ":")
+	if _, err := componentCID(component); !ok || err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Expected format: ip:component (e.g., 192.168.1.1:temperature:100)",
+		)
+		return
+	}
+	components, diags := types.ListValueFrom(ctx, types.StringType, []string{component})
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip"), ip)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("components"), components)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reboot"), true)...)
+}
+
+func (c *sensorAddonPeripheralResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sensorAddonPeripheralResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	component, diags := firstComponent(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	if err := callRPC(client, "SensorAddon.RemovePeripheral", map[string]any{"component": component}, nil); err != nil {
+		resp.Diagnostics.AddError("Failed to remove Sensor Add-on peripheral", err.Error())
+		return
+	}
+	if state.Reboot.ValueBool() {
+		if err := rebootDevice(ctx, c.credentials, state.IP.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Failed to reboot device", err.Error())
+			return
+		}
+	}
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSensorAddon answers the SensorAddon methods. A dht22 creates a
+// temperature and a humidity component, the other types one component.
+type fakeSensorAddon struct {
+	peripherals    sensorAddonPeripherals
+	failGet        bool
+	removeRequests []string
+}
+
+func (d *fakeSensorAddon) handle(method string, raw json.RawMessage) (any, *rpcError) {
+	var params struct {
+		Type      string                     `json:"type"`
+		Attrs     sensorAddonPeripheralAttrs `json:"attrs"`
+		Component string                     `json:"component"`
+	}
+	_ = json.Unmarshal(raw, &params)
+
+	switch method {
+	case "SensorAddon.AddPeripheral":
+		cid := int32(100)
+		if params.Attrs.CID != nil {
+			cid = *params.Attrs.CID
+		}
+		kinds := map[string][]string{"dht22": {"temperature", "humidity"}, "ds18b20": {"temperature"}}[params.Type]
+		if d.peripherals[params.Type] == nil {
+			d.peripherals[params.Type] = map[string]sensorAddonPeripheralAttrs{}
+		}
+		added := map[string]any{}
+		for _, kind := range kinds {
+			component := fmt.Sprintf("%s:%d", kind, cid)
+			d.peripherals[params.Type][component] = sensorAddonPeripheralAttrs{Addr: params.Attrs.Addr}
+			added[component] = map[string]any{}
+		}
+		return added, nil
+	case "SensorAddon.GetPeripherals":
+		if d.failGet {
+			return nil, &rpcError{Code: 500, Message: "internal error"}
+		}
+		return d.peripherals, nil
+	case "SensorAddon.RemovePeripheral":
+		d.removeRequests = append(d.removeRequests, params.Component)
+		cid, _ := componentCID(params.Component)
+		for _, entries := range d.peripherals {
+			for key := range entries {
+				if id, _ := componentCID(key); id == cid {
+					delete(entries, key)
+				}
+			}
+		}
+		return nil, nil
+	}
+	return nil, &rpcError{Code: 404, Message: "No handler for " + method}
+}
+
+// createSensorAddonPeripheral creates a peripheral of peripheralType on the
+// device at ip without rebooting it.
+func createSensorAddonPeripheral(t *testing.T, ip, peripheralType string) resource.CreateResponse {
+	t.Helper()
+	res := NewSensorAddonPeripheralResource()
+	config := resourceConfig(t, res, map[string]tftypes.Value{
+		"ip":         tftypes.NewValue(tftypes.String, ip),
+		"type":       tftypes.NewValue(tftypes.String, peripheralType),
+		"cid":        tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		"addr":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"components": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+		"reboot":     tftypes.NewValue(tftypes.Bool, false),
+	})
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: config.Schema, Raw: tftypes.NewValue(config.Raw.Type(), nil)}}
+	res.Create(context.Background(), resource.CreateRequest{
+		Config: config,
+		Plan:   tfsdk.Plan{Schema: config.Schema, Raw: config.Raw},
+	}, &resp)
+	return resp
+}
+
+func TestSensorAddonPeripheralLifecycle(t *testing.T) {
+	ctx := context.Background()
+	device := &fakeSensorAddon{peripherals: sensorAddonPeripherals{}}
+	ip := fakeDeviceIP(t, device.handle)
+	res := NewSensorAddonPeripheralResource()
+
+	createResp := createSensorAddonPeripheral(t, ip, "dht22")
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	var state sensorAddonPeripheralResourceModel
+	require.False(t, createResp.State.Get(ctx, &state).HasError())
+	require.Equal(t, int32(100), state.CID.ValueInt32())
+	require.True(t, state.Addr.IsNull())
+	var components []string
+	require.False(t, state.Components.ElementsAs(ctx, &components, false).HasError())
+	require.Equal(t, []string{"humidity:100", "temperature:100"}, components)
+
+	readResp := resource.ReadResponse{State: createResp.State}
+	res.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	require.True(t, readResp.State.Raw.Equal(createResp.State.Raw))
+
+	deleteResp := resource.DeleteResponse{State: createResp.State}
+	res.Delete(ctx, resource.DeleteRequest{State: createResp.State}, &deleteResp)
+	require.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+	require.Equal(t, []string{"humidity:100"}, device.removeRequests)
+	require.Empty(t, device.peripherals["dht22"])
+
+	// A peripheral removed outside of Terraform drops out of state.
+	readResp = resource.ReadResponse{State: createResp.State}
+	res.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	require.True(t, readResp.State.Raw.IsNull())
+}
+
+func TestSensorAddonPeripheralCreateKeepsStateOnFailedRead(t *testing.T) {
+	device := &fakeSensorAddon{peripherals: sensorAddonPeripherals{}, failGet: true}
+	ip := fakeDeviceIP(t, device.handle)
+
+	resp := createSensorAddonPeripheral(t, ip, "ds18b20")
+	require.True(t, resp.Diagnostics.HasError())
+
+	// The peripheral was added, so it must not be forgotten.
+	var state sensorAddonPeripheralResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	require.Equal(t, int32(100), state.CID.ValueInt32())
+	require.Len(t, state.Components.Elements(), 1)
+}
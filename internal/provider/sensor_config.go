@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// sensorConfigResourceModel holds the settings shared by the Temperature,
+// Humidity, Illuminance and Voltmeter components.
+type sensorConfigResourceModel struct {
+	IP                types.String `tfsdk:"ip"`
+	ID                types.Int32  `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Sleepy            types.Bool   `tfsdk:"sleepy"`
+	WakeTimeout       types.Int64  `tfsdk:"wake_timeout"`
+	WakeListenAddress types.String `tfsdk:"wake_listen_address"`
+}
+
+// sensorSchemaAttributes returns the attributes shared by the sensor
+// components.
+func sensorSchemaAttributes(component string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"ip": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The IP address of the Shelly device.",
+		},
+		"id": schema.Int32Attribute{
+			Required:            true,
+			MarkdownDescription: fmt.Sprintf("The ID of the %s component to configure. Sensor Add-on peripherals start at 100.", component),
+		},
+		"name": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("Name of the %s instance.", component),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"sleepy": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+			MarkdownDescription: "Set for battery powered devices that sleep most of the time and only answer while awake. " +
+				"Changes then wait for the device to wake up, and refreshing keeps the last known configuration when the device does not answer. Defaults to false.",
+		},
+		"wake_timeout": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(defaultWakeTimeout),
+			MarkdownDescription: fmt.Sprintf("Seconds to wait for a sleepy device to wake up before a change fails. Defaults to %d.", defaultWakeTimeout),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"wake_listen_address": schema.StringAttribute{
+			Optional: true,
+			MarkdownDescription: "Address, e.g. `:8765`, on which the provider accepts the outbound WebSocket connection of a sleepy device while waiting for it. " +
+				"Point the device's outbound WebSocket (see `shelly_outbound_websocket_config`) at `ws://<provider host>:8765` " +
+				"to push pending changes as soon as the device connects, without having to catch it awake over HTTP.",
+		},
+	}
+}
+
+// deviceAsleep reports whether err means a sleepy device did not answer,
+// as opposed to the device rejecting the request.
+func deviceAsleep(sleepy types.Bool, err error) bool {
+	return sleepy.ValueBool() && !isRPCError(err)
+}
+
+// sensorConfig mirrors the config fields shared by the sensor components.
+type sensorConfig struct {
+	ID   int     `json:"id"`
+	Name *string `json:"name,omitempty"`
+}
+
+func sensorConfigFromPlan(plan *sensorConfigResourceModel) sensorConfig {
+	return sensorConfig{
+		ID:   int(plan.ID.ValueInt32()),
+		Name: stringPointer(plan.Name),
+	}
+}
+
+// connectSensor returns a deviceRPC for the device of plan, plus a function
+// releasing it. Sleepy devices are waited for until they wake up.
+func connectSensor(ctx context.Context, credentials *deviceCredentials, plan *sensorConfigResourceModel, diags *diag.Diagnostics) (deviceRPC, func()) {
+	if !plan.Sleepy.ValueBool() {
+		return httpDeviceRPC(credentials, plan.IP.ValueString())
+	}
+	timeout := time.Duration(plan.WakeTimeout.ValueInt64()) * time.Second
+	rpc, release, err := waitForWake(ctx, credentials, plan.IP.ValueString(), plan.WakeListenAddress.ValueString(), timeout)
+	if err != nil {
+		diags.AddError("Device did not wake up",
+			fmt.Sprintf("%v. Wake the device, e.g. by pressing its button, or raise wake_timeout.", err))
+		return nil, nil
+	}
+	return rpc, release
+}
+
+// getSensorConfig calls <component>.GetConfig and decodes the result into
+// config.
+func getSensorConfig(rpc deviceRPC, state *sensorConfigResourceModel, component string, config any) error {
+	params := map[string]any{"id": state.ID.ValueInt32()}
+	return rpc(component+".GetConfig", params, config)
+}
+
+// setSensorConfig applies config with <component>.SetConfig.
+func setSensorConfig(rpc deviceRPC, plan *sensorConfigResourceModel, component string, config any, diags *diag.Diagnostics) error {
+	params := map[string]any{"id": plan.ID.ValueInt32(), "config": config}
+	if err := rpc(component+".SetConfig", params, nil); err != nil {
+		diags.AddError(fmt.Sprintf("Failed to set %s config", component), err.Error())
+		return err
+	}
+	return nil
+}
+
+// importSensorState imports sensor resources given as ip:id.
+func importSensorState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, component string) {
+	importStateIPAndID(ctx, req, resp, "id", component)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sleepy"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wake_timeout"), defaultWakeTimeout)...)
+}
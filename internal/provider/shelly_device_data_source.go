@@ -10,13 +10,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"resty.dev/v3"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ datasource.DataSource = &ShellyDeviceDataSource{}
+var (
+	_ datasource.DataSource              = &ShellyDeviceDataSource{}
+	_ datasource.DataSourceWithConfigure = &ShellyDeviceDataSource{}
+)
 
 type ShellyDeviceDataSource struct {
+	credentials *deviceCredentials
 }
 
 type ShellyDeviceModel struct {
@@ -54,6 +57,7 @@ func (d *ShellyDeviceDataSource) Schema(ctx context.Context, req datasource.Sche
 }
 
 func (d *ShellyDeviceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
 }
 
 func (d *ShellyDeviceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -65,9 +69,8 @@ func (d *ShellyDeviceDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	client := resty.New()
+	client := newDeviceClient(d.credentials, data.IP.ValueString())
 	defer client.Close()
-	client.SetBaseURL("http://" + data.IP.ValueString())
 
 	statusReq := &shelly.SysGetConfigRequest{}
 	statusResp, _, err := statusReq.Do(client)
@@ -19,12 +19,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"resty.dev/v3"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &switchConfigResource{}
+	_ resource.ResourceWithConfigure   = &switchConfigResource{}
 	_ resource.ResourceWithImportState = &switchConfigResource{}
 )
 
@@ -42,12 +42,17 @@ type switchConfigResourceModel struct {
 }
 
 type switchConfigResource struct {
+	credentials *deviceCredentials
 }
 
 func (c *switchConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_switch_config"
 }
 
+func (c *switchConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
 func (c *switchConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
@@ -113,9 +118,8 @@ func (c *switchConfigResource) Read(ctx context.Context, req resource.ReadReques
 	statusReq := &shelly.SwitchGetConfigRequest{
 		ID: int(state.ID.ValueInt32()),
 	}
-	client := resty.New()
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
 	defer client.Close()
-	client.SetBaseURL("http://" + state.IP.ValueString())
 
 	statusResp, _, err := statusReq.Do(client)
 	if err != nil {
@@ -131,7 +135,7 @@ func (c *switchConfigResource) Read(ctx context.Context, req resource.ReadReques
 	resp.Diagnostics.Append(diags...)
 }
 
-func setSwitchConfig(plan switchConfigResourceModel, diags *diag.Diagnostics) error {
+func setSwitchConfig(credentials *deviceCredentials, plan switchConfigResourceModel, diags *diag.Diagnostics) error {
 	var switchConfig shelly.SwitchConfig
 	switchConfig.ID = int(plan.ID.ValueInt32())
 	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
@@ -155,9 +159,8 @@ func setSwitchConfig(plan switchConfigResourceModel, diags *diag.Diagnostics) er
 		Config: switchConfig,
 	}
 
-	client := resty.New()
+	client := newDeviceClient(credentials, plan.IP.ValueString())
 	defer client.Close()
-	client.SetBaseURL("http://" + plan.IP.ValueString())
 
 	_, _, err := statusReq.Do(client)
 	if err != nil {
@@ -175,7 +178,7 @@ func (c *switchConfigResource) Create(ctx context.Context, req resource.CreateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := setSwitchConfig(plan, &resp.Diagnostics); err != nil {
+	if err := setSwitchConfig(c.credentials, plan, &resp.Diagnostics); err != nil {
 		return
 	}
 	diags = resp.State.Set(ctx, &plan)
@@ -189,7 +192,7 @@ func (c *switchConfigResource) Update(ctx context.Context, req resource.UpdateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := setSwitchConfig(plan, &resp.Diagnostics); err != nil {
+	if err := setSwitchConfig(c.credentials, plan, &resp.Diagnostics); err != nil {
 		return
 	}
 	diags = resp.State.Set(ctx, &plan)
@@ -14,12 +14,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"resty.dev/v3"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &sysConfigResource{}
+	_ resource.ResourceWithConfigure   = &sysConfigResource{}
 	_ resource.ResourceWithImportState = &sysConfigResource{}
 )
 
@@ -33,12 +33,17 @@ type sysConfigResourceModel struct {
 }
 
 type sysConfigResource struct {
+	credentials *deviceCredentials
 }
 
 func (c *sysConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_sys_config"
 }
 
+func (c *sysConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
 func (c *sysConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
@@ -68,9 +73,8 @@ func (c *sysConfigResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	statusReq := &shelly.SysGetConfigRequest{}
 
-	client := resty.New()
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
 	defer client.Close()
-	client.SetBaseURL("http://" + state.IP.ValueString())
 
 	statusResp, _, err := statusReq.Do(client)
 	if err != nil {
@@ -91,7 +95,7 @@ func (c *sysConfigResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 }
 
-func setSysConfig(plan sysConfigResourceModel, diags *diag.Diagnostics) error {
+func setSysConfig(credentials *deviceCredentials, plan sysConfigResourceModel, diags *diag.Diagnostics) error {
 	var sysConfig shelly.SysDeviceConfig
 	if !plan.Name.IsNull() && !plan.Name.IsUnknown() {
 		nameStr := plan.Name.ValueString()
@@ -104,9 +108,8 @@ func setSysConfig(plan sysConfigResourceModel, diags *diag.Diagnostics) error {
 		},
 	}
 
-	client := resty.New()
+	client := newDeviceClient(credentials, plan.IP.ValueString())
 	defer client.Close()
-	client.SetBaseURL("http://" + plan.IP.ValueString())
 
 	_, _, err := statusReq.Do(client)
 	if err != nil {
@@ -124,7 +127,7 @@ func (c *sysConfigResource) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := setSysConfig(plan, &resp.Diagnostics); err != nil {
+	if err := setSysConfig(c.credentials, plan, &resp.Diagnostics); err != nil {
 		return
 	}
 	diags = resp.State.Set(ctx, &plan)
@@ -138,7 +141,7 @@ func (c *sysConfigResource) Update(ctx context.Context, req resource.UpdateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := setSysConfig(plan, &resp.Diagnostics); err != nil {
+	if err := setSysConfig(c.credentials, plan, &resp.Diagnostics); err != nil {
 		return
 	}
 	diags = resp.State.Set(ctx, &plan)
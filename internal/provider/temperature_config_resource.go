@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &temperatureConfigResource{}
+	_ resource.ResourceWithConfigure   = &temperatureConfigResource{}
+	_ resource.ResourceWithImportState = &temperatureConfigResource{}
+)
+
+func NewTemperatureConfigResource() resource.Resource {
+	return &temperatureConfigResource{}
+}
+
+type temperatureConfigResourceModel struct {
+	sensorConfigResourceModel
+	ReportThrC types.Float64 `tfsdk:"report_thr_c"`
+	OffsetC    types.Float64 `tfsdk:"offset_c"`
+}
+
+// temperatureConfig mirrors the config object of Temperature.GetConfig /
+// Temperature.SetConfig.
+type temperatureConfig struct {
+	sensorConfig
+	ReportThrC *float64 `json:"report_thr_C,omitempty"`
+	OffsetC    *float64 `json:"offset_C,omitempty"`
+}
+
+type temperatureConfigResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *temperatureConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_temperature_config"
+}
+
+func (c *temperatureConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *temperatureConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := sensorSchemaAttributes("Temperature")
+	attributes["report_thr_c"] = optionalFloat64Attribute("Temperature change in °C that triggers a status update.")
+	attributes["offset_c"] = signedFloat64Attribute("Offset in °C added to the measured temperature.")
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func readTemperatureConfig(rpc deviceRPC, state *temperatureConfigResourceModel) error {
+	var config temperatureConfig
+	if err := getSensorConfig(rpc, &state.sensorConfigResourceModel, "Temperature", &config); err != nil {
+		return err
+	}
+
+	state.Name = types.StringPointerValue(config.Name)
+	state.ReportThrC = types.Float64PointerValue(config.ReportThrC)
+	state.OffsetC = types.Float64PointerValue(config.OffsetC)
+	return nil
+}
+
+func (c *temperatureConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state temperatureConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rpc, release := httpDeviceRPC(c.credentials, state.IP.ValueString())
+	defer release()
+	if err := readTemperatureConfig(rpc, &state); err != nil {
+		if deviceAsleep(state.Sleepy, err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to query Temperature config", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func setTemperatureConfig(rpc deviceRPC, plan *temperatureConfigResourceModel, diags *diag.Diagnostics) error {
+	config := temperatureConfig{
+		sensorConfig: sensorConfigFromPlan(&plan.sensorConfigResourceModel),
+		ReportThrC:   float64Pointer(plan.ReportThrC),
+		OffsetC:      float64Pointer(plan.OffsetC),
+	}
+	return setSensorConfig(rpc, &plan.sensorConfigResourceModel, "Temperature", config, diags)
+}
+
+func (c *temperatureConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan temperatureConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rpc, release := connectSensor(ctx, c.credentials, &plan.sensorConfigResourceModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer release()
+	if err := setTemperatureConfig(rpc, &plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readTemperatureConfig(rpc, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query Temperature config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *temperatureConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan temperatureConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rpc, release := connectSensor(ctx, c.credentials, &plan.sensorConfigResourceModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer release()
+	if err := setTemperatureConfig(rpc, &plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readTemperatureConfig(rpc, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query Temperature config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *temperatureConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSensorState(ctx, req, resp, "Temperature")
+}
+
+func (c *temperatureConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
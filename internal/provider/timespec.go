@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// timespecField describes one field of a timespec. names, if set, are the
+// names accepted for the values starting at min.
+type timespecField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+var (
+	timespecMonths   = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+	timespecWeekdays = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+	// timespecFields are the six fields of a cron timespec. A sunrise or
+	// sunset timespec only has the last three.
+	timespecFields = []timespecField{
+		{name: "second", min: 0, max: 59},
+		{name: "minute", min: 0, max: 59},
+		{name: "hour", min: 0, max: 23},
+		{name: "day of month", min: 1, max: 31},
+		{name: "month", min: 1, max: 12, names: timespecMonths},
+		{name: "weekday", min: 0, max: 6, names: timespecWeekdays},
+	}
+
+	// sunOffsetRegexp matches the offset of a sunrise or sunset timespec,
+	// e.g. +30m or -1h15m.
+	sunOffsetRegexp = regexp.MustCompile(`^[+-](\d+h)?(\d+m)?(\d+s)?$`)
+)
+
+// validateTimespec checks a timespec, either six cron fields
+// (`0 30 7 * * MON-FRI`) or a sunrise/sunset event with an optional offset
+// followed by all three of the day of month, month and weekday fields
+// (`@sunset-30m * * SAT,SUN`).
+func validateTimespec(timespec string) error {
+	fields := strings.Fields(timespec)
+	if len(fields) == 0 {
+		return fmt.Errorf("timespec is empty")
+	}
+
+	specs := timespecFields
+	if strings.HasPrefix(fields[0], "@") {
+		if err := validateSunEvent(fields[0]); err != nil {
+			return err
+		}
+		fields = fields[1:]
+		specs = timespecFields[3:]
+	}
+	if len(fields) != len(specs) {
+		return fmt.Errorf("timespec %q has %d fields, expected %d (%s)", timespec, len(fields), len(specs), timespecFieldNames(specs))
+	}
+	for i, field := range fields {
+		if err := validateTimespecField(specs[i], field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func timespecFieldNames(specs []timespecField) string {
+	names := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		names = append(names, spec.name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// validateSunEvent checks the leading @sunrise or @sunset field of a
+// timespec, including its offset.
+func validateSunEvent(field string) error {
+	event, offset := field[1:], ""
+	if i := strings.IndexAny(event, "+-"); i >= 0 {
+		event, offset = event[:i], event[i:]
+	}
+	if event != "sunrise" && event != "sunset" {
+		return fmt.Errorf("unknown event %q, expected @sunrise or @sunset", field[:len(field)-len(offset)])
+	}
+	if offset != "" && (len(offset) < 3 || !sunOffsetRegexp.MatchString(offset)) {
+		return fmt.Errorf("invalid offset %q, expected e.g. +30m or -1h15m", offset)
+	}
+	return nil
+}
+
+// validateTimespecField checks one field: `*` or a comma separated list of
+// values and ranges, each with an optional `/step`.
+func validateTimespecField(spec timespecField, field string) error {
+	for _, part := range strings.Split(field, ",") {
+		base, step, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			if n, err := strconv.Atoi(step); err != nil || n < 1 {
+				return fmt.Errorf("invalid step %q in %s field %q", step, spec.name, field)
+			}
+		}
+		if base == "*" {
+			continue
+		}
+		from, to, isRange := strings.Cut(base, "-")
+		first, err := timespecValue(spec, from)
+		if err != nil {
+			return fmt.Errorf("%w in %s field %q", err, spec.name, field)
+		}
+		if !isRange {
+			continue
+		}
+		last, err := timespecValue(spec, to)
+		if err != nil {
+			return fmt.Errorf("%w in %s field %q", err, spec.name, field)
+		}
+		if first > last {
+			return fmt.Errorf("range %q is reversed in %s field %q", base, spec.name, field)
+		}
+	}
+	return nil
+}
+
+func timespecValue(spec timespecField, value string) (int, error) {
+	if i := slices.Index(spec.names, strings.ToUpper(value)); i >= 0 {
+		return spec.min + i, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < spec.min || n > spec.max {
+		if spec.names != nil {
+			return 0, fmt.Errorf("invalid value %q, expected %d-%d or %s-%s", value, spec.min, spec.max, spec.names[0], spec.names[len(spec.names)-1])
+		}
+		return 0, fmt.Errorf("invalid value %q, expected %d-%d", value, spec.min, spec.max)
+	}
+	return n, nil
+}
+
+// weekdaysField joins days into the weekday field of a timespec, `*` if
+// there are none.
+func weekdaysField(days []string) (string, error) {
+	if len(days) == 0 {
+		return "*", nil
+	}
+	field := strings.ToUpper(strings.Join(days, ","))
+	if err := validateTimespecField(timespecFields[5], field); err != nil {
+		return "", err
+	}
+	return field, nil
+}
+
+// buildTimespec returns the timespec running at clock time `HH:MM` or
+// `HH:MM:SS` on days.
+func buildTimespec(clock string, days []string) (string, error) {
+	parts := strings.Split(clock, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return "", fmt.Errorf("invalid time %q, expected HH:MM or HH:MM:SS", clock)
+	}
+	if len(parts) == 2 {
+		parts = append(parts, "0")
+	}
+	var values [3]int
+	for i, spec := range []timespecField{timespecFields[2], timespecFields[1], timespecFields[0]} {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil || n < spec.min || n > spec.max {
+			return "", fmt.Errorf("invalid %s %q in time %q", spec.name, parts[i], clock)
+		}
+		values[i] = n
+	}
+	weekdays, err := weekdaysField(days)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d %d %d * * %s", values[2], values[1], values[0], weekdays), nil
+}
+
+// buildSunTimespec returns the timespec running at sunrise or sunset, moved
+// by offset (e.g. `30m` or `-1h15m`), on days.
+func buildSunTimespec(event, offset string, days []string) (string, error) {
+	if offset != "" && offset[0] != '+' && offset[0] != '-' {
+		offset = "+" + offset
+	}
+	field := "@" + event + offset
+	if err := validateSunEvent(field); err != nil {
+		return "", err
+	}
+	weekdays, err := weekdaysField(days)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s * * %s", field, weekdays), nil
+}
+
+var _ validator.String = timespecValidator{}
+
+// timespecValidator validates a schedule timespec.
+type timespecValidator struct{}
+
+func (v timespecValidator) Description(_ context.Context) string {
+	return "value must be a valid timespec"
+}
+
+func (v timespecValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timespecValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := validateTimespec(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid timespec", err.Error())
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ function.Function = &timespecFunction{}
+	_ function.Function = &sunTimespecFunction{}
+)
+
+func NewTimespecFunction() function.Function {
+	return &timespecFunction{}
+}
+
+type timespecFunction struct{}
+
+func (f *timespecFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "timespec"
+}
+
+func (f *timespecFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a schedule timespec for a time of day",
+		MarkdownDescription: "Returns the timespec of a schedule running at a time of day on the given weekdays, e.g. `timespec(\"07:30\", [\"MON-FRI\"])` returns `0 30 7 * * MON-FRI`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "time",
+				MarkdownDescription: "The time of day as `HH:MM` or `HH:MM:SS`.",
+			},
+			function.ListParameter{
+				Name:                "weekdays",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Weekdays as names (`MON`), numbers (`1`, Sunday is `0`) or ranges (`MON-FRI`). Empty for every day.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *timespecFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var clock string
+	var days []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &clock, &days))
+	if resp.Error != nil {
+		return
+	}
+
+	timespec, err := buildTimespec(clock, days)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, timespec))
+}
+
+func NewSunTimespecFunction() function.Function {
+	return &sunTimespecFunction{}
+}
+
+type sunTimespecFunction struct{}
+
+func (f *sunTimespecFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sun_timespec"
+}
+
+func (f *sunTimespecFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds a schedule timespec relative to sunrise or sunset",
+		MarkdownDescription: "Returns the timespec of a schedule running at sunrise or sunset on the given weekdays, e.g. `sun_timespec(\"sunset\", \"-30m\", [])` returns `@sunset-30m * * *`. The device needs its location set to compute these times.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "event",
+				MarkdownDescription: "Either `sunrise` or `sunset`.",
+			},
+			function.StringParameter{
+				Name:                "offset",
+				MarkdownDescription: "Offset from the event in hours, minutes and seconds, e.g. `30m`, `-1h15m` or `\"\"` for none.",
+			},
+			function.ListParameter{
+				Name:                "weekdays",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Weekdays as names (`MON`), numbers (`1`, Sunday is `0`) or ranges (`MON-FRI`). Empty for every day.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *sunTimespecFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var event, offset string
+	var days []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &event, &offset, &days))
+	if resp.Error != nil {
+		return
+	}
+
+	timespec, err := buildSunTimespec(event, offset, days)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, timespec))
+}
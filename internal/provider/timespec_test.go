@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTimespec(t *testing.T) {
+	for _, timespec := range []string{
+		"0 0 7 * * MON-FRI",
+		"*/15 * * * * *",
+		"0 0,30 8-20/2 1 JAN-MAR,dec 0",
+		"@sunset-1h15m * * SAT,SUN",
+		"@sunrise+30s 1-15 * *",
+	} {
+		require.NoError(t, validateTimespec(timespec), timespec)
+	}
+
+	for _, timespec := range []string{
+		"",
+		"0 0 7 * *",
+		"60 0 7 * * *",
+		"0 0 7 0 * *",
+		"0 0 7 * * FRI-MON",
+		"0 0 7 * * MO",
+		"*/0 * * * * *",
+		"@noon * * *",
+		"@sunset+ * * *",
+		"@sunset+30 * * *",
+		"@sunset+30m * *",
+		"@sunrise",
+		"@sunset *",
+		"@sunset * *",
+		"@sunset * * * *",
+	} {
+		require.Error(t, validateTimespec(timespec), timespec)
+	}
+}
+
+func TestBuildTimespec(t *testing.T) {
+	timespec, err := buildTimespec("07:30", []string{"mon-fri"})
+	require.NoError(t, err)
+	require.Equal(t, "0 30 7 * * MON-FRI", timespec)
+
+	timespec, err = buildTimespec("23:59:30", nil)
+	require.NoError(t, err)
+	require.Equal(t, "30 59 23 * * *", timespec)
+
+	_, err = buildTimespec("24:00", nil)
+	require.Error(t, err)
+	_, err = buildTimespec("7", nil)
+	require.Error(t, err)
+	_, err = buildTimespec("07:30", []string{"MONDAY"})
+	require.Error(t, err)
+}
+
+func TestBuildSunTimespec(t *testing.T) {
+	timespec, err := buildSunTimespec("sunset", "-30m", []string{"SAT", "SUN"})
+	require.NoError(t, err)
+	require.Equal(t, "@sunset-30m * * SAT,SUN", timespec)
+
+	timespec, err = buildSunTimespec("sunrise", "1h", nil)
+	require.NoError(t, err)
+	require.Equal(t, "@sunrise+1h * * *", timespec)
+
+	timespec, err = buildSunTimespec("sunrise", "", nil)
+	require.NoError(t, err)
+	require.Equal(t, "@sunrise * * *", timespec)
+
+	_, err = buildSunTimespec("noon", "", nil)
+	require.Error(t, err)
+	_, err = buildSunTimespec("sunset", "30", nil)
+	require.Error(t, err)
+}
+
+func TestTimespecFunctions(t *testing.T) {
+	ctx := context.Background()
+	weekdays := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("MON-FRI")})
+
+	run := func(f function.Function, args ...attr.Value) (string, *function.FuncError) {
+		var definition function.DefinitionResponse
+		f.Definition(ctx, function.DefinitionRequest{}, &definition)
+		require.Len(t, definition.Definition.Parameters, len(args))
+
+		req := function.RunRequest{Arguments: function.NewArgumentsData(args)}
+		resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+		f.Run(ctx, req, &resp)
+		return resp.Result.Value().(types.String).ValueString(), resp.Error
+	}
+
+	timespec, err := run(NewTimespecFunction(), types.StringValue("06:45"), weekdays)
+	require.Nil(t, err)
+	require.Equal(t, "0 45 6 * * MON-FRI", timespec)
+
+	timespec, err = run(NewSunTimespecFunction(), types.StringValue("sunset"), types.StringValue("+15m"), weekdays)
+	require.Nil(t, err)
+	require.Equal(t, "@sunset+15m * * MON-FRI", timespec)
+
+	_, err = run(NewTimespecFunction(), types.StringValue("6pm"), weekdays)
+	require.NotNil(t, err)
+}
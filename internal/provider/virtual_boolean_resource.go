@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"resty.dev/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &virtualBooleanResource{}
+	_ resource.ResourceWithConfigure   = &virtualBooleanResource{}
+	_ resource.ResourceWithImportState = &virtualBooleanResource{}
+)
+
+func NewVirtualBooleanResource() resource.Resource {
+	return &virtualBooleanResource{}
+}
+
+type virtualBooleanResourceModel struct {
+	virtualComponentResourceModel
+	View         types.String `tfsdk:"view"`
+	Titles       types.List   `tfsdk:"titles"`
+	Persisted    types.Bool   `tfsdk:"persisted"`
+	DefaultValue types.Bool   `tfsdk:"default_value"`
+}
+
+// virtualBooleanConfig mirrors the config object of Boolean.GetConfig /
+// Boolean.SetConfig.
+type virtualBooleanConfig struct {
+	virtualConfig
+	Persisted    *bool `json:"persisted,omitempty"`
+	DefaultValue *bool `json:"default_value,omitempty"`
+}
+
+type virtualBooleanResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *virtualBooleanResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_boolean"
+}
+
+func (c *virtualBooleanResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *virtualBooleanResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := virtualSchemaAttributes("Boolean")
+	attributes["view"] = virtualViewAttribute("toggle", "label")
+	attributes["titles"] = schema.ListAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "Titles shown for false and true, e.g. `[\"Off\", \"On\"]`.",
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.List{
+			listvalidator.SizeBetween(2, 2),
+		},
+	}
+	attributes["persisted"] = virtualPersistedAttribute()
+	attributes["default_value"] = schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Value after a reboot if the value is not persisted.",
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a virtual Boolean component, e.g. a toggle in the app that scripts can read.",
+		Attributes:          attributes,
+	}
+}
+
+func readVirtualBooleanConfig(ctx context.Context, client *resty.Client, state *virtualBooleanResourceModel) (diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+	var config virtualBooleanConfig
+	if err := getVirtualConfig(client, &state.virtualComponentResourceModel, "Boolean", &config); err != nil {
+		return diags, err
+	}
+
+	ui := setVirtualState(&state.virtualComponentResourceModel, config.virtualConfig)
+	state.View = types.StringPointerValue(ui.View)
+	state.Persisted = types.BoolPointerValue(config.Persisted)
+	state.DefaultValue = types.BoolPointerValue(config.DefaultValue)
+	var titles []string
+	if json.Unmarshal(ui.Titles, &titles) == nil && titles != nil {
+		state.Titles, diags = types.ListValueFrom(ctx, types.StringType, titles)
+	} else {
+		state.Titles = types.ListNull(types.StringType)
+	}
+	return diags, nil
+}
+
+func virtualBooleanConfigFromPlan(ctx context.Context, plan *virtualBooleanResourceModel, diags *diag.Diagnostics) virtualBooleanConfig {
+	ui := virtualUI{View: stringPointer(plan.View)}
+	if !plan.Titles.IsNull() && !plan.Titles.IsUnknown() {
+		var titles []string
+		diags.Append(plan.Titles.ElementsAs(ctx, &titles, false)...)
+		ui.Titles, _ = json.Marshal(titles)
+	}
+	return virtualBooleanConfig{
+		virtualConfig: virtualConfigFromPlan(&plan.virtualComponentResourceModel, ui),
+		Persisted:     boolPointer(plan.Persisted),
+		DefaultValue:  boolPointer(plan.DefaultValue),
+	}
+}
+
+func (c *virtualBooleanResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state virtualBooleanResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	diags, err := readVirtualBooleanConfig(ctx, client, &state)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Boolean config", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualBooleanResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan virtualBooleanResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	config := virtualBooleanConfigFromPlan(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := addVirtualComponent(client, &plan.virtualComponentResourceModel, "Boolean", config); err != nil {
+		resp.Diagnostics.AddError("Failed to add Boolean component", err.Error())
+		return
+	}
+	// Record the component right away so that it is not leaked if reading
+	// it back fails.
+	resp.Diagnostics.Append(recordVirtualComponent(ctx, &resp.State, &plan.virtualComponentResourceModel)...)
+	diags, err := readVirtualBooleanConfig(ctx, client, &plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Boolean config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualBooleanResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan virtualBooleanResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	config := virtualBooleanConfigFromPlan(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setVirtualConfig(client, &plan.virtualComponentResourceModel, "Boolean", config); err != nil {
+		resp.Diagnostics.AddError("Failed to set Boolean config", err.Error())
+		return
+	}
+	diags, err := readVirtualBooleanConfig(ctx, client, &plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Boolean config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualBooleanResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importDynamicComponentState(ctx, req, resp, "Boolean")
+}
+
+func (c *virtualBooleanResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state virtualBooleanResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	if err := deleteVirtualComponent(client, &state.virtualComponentResourceModel, "Boolean"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete Boolean component", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"resty.dev/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &virtualButtonResource{}
+	_ resource.ResourceWithConfigure   = &virtualButtonResource{}
+	_ resource.ResourceWithImportState = &virtualButtonResource{}
+)
+
+func NewVirtualButtonResource() resource.Resource {
+	return &virtualButtonResource{}
+}
+
+type virtualButtonResourceModel struct {
+	virtualComponentResourceModel
+}
+
+// virtualButtonConfig mirrors the config object of Button.GetConfig /
+// Button.SetConfig.
+type virtualButtonConfig struct {
+	virtualConfig
+}
+
+type virtualButtonResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *virtualButtonResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_button"
+}
+
+func (c *virtualButtonResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *virtualButtonResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a virtual Button component, which emits push events that scripts and webhooks can react to.",
+		Attributes:          virtualSchemaAttributes("Button"),
+	}
+}
+
+func readVirtualButtonConfig(ctx context.Context, client *resty.Client, state *virtualButtonResourceModel) (diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+	var config virtualButtonConfig
+	if err := getVirtualConfig(client, &state.virtualComponentResourceModel, "Button", &config); err != nil {
+		return diags, err
+	}
+
+	setVirtualState(&state.virtualComponentResourceModel, config.virtualConfig)
+	return diags, nil
+}
+
+// virtualButtonConfigFromPlan returns the config for plan. Buttons only
+// have the button view.
+func virtualButtonConfigFromPlan(plan *virtualButtonResourceModel) virtualButtonConfig {
+	view := "button"
+	return virtualButtonConfig{
+		virtualConfig: virtualConfigFromPlan(&plan.virtualComponentResourceModel, virtualUI{View: &view}),
+	}
+}
+
+func (c *virtualButtonResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state virtualButtonResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	diags, err := readVirtualButtonConfig(ctx, client, &state)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Button config", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualButtonResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan virtualButtonResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	config := virtualButtonConfigFromPlan(&plan)
+	if err := addVirtualComponent(client, &plan.virtualComponentResourceModel, "Button", config); err != nil {
+		resp.Diagnostics.AddError("Failed to add Button component", err.Error())
+		return
+	}
+	// Record the component right away so that it is not leaked if reading
+	// it back fails.
+	resp.Diagnostics.Append(recordVirtualComponent(ctx, &resp.State, &plan.virtualComponentResourceModel)...)
+	diags, err := readVirtualButtonConfig(ctx, client, &plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Button config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualButtonResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan virtualButtonResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	config := virtualButtonConfigFromPlan(&plan)
+	if err := setVirtualConfig(client, &plan.virtualComponentResourceModel, "Button", config); err != nil {
+		resp.Diagnostics.AddError("Failed to set Button config", err.Error())
+		return
+	}
+	diags, err := readVirtualButtonConfig(ctx, client, &plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Button config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualButtonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importDynamicComponentState(ctx, req, resp, "Button")
+}
+
+func (c *virtualButtonResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state virtualButtonResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	if err := deleteVirtualComponent(client, &state.virtualComponentResourceModel, "Button"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete Button component", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
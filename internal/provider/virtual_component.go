@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"resty.dev/v3"
+)
+
+// virtualComponentResourceModel holds the settings shared by the Boolean,
+// Number, Text, Enum, Button and Group virtual components.
+type virtualComponentResourceModel struct {
+	IP   types.String `tfsdk:"ip"`
+	ID   types.Int32  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Icon types.String `tfsdk:"icon"`
+}
+
+// virtualConfig mirrors the config fields shared by the virtual components.
+type virtualConfig struct {
+	Name *string      `json:"name,omitempty"`
+	Meta *virtualMeta `json:"meta,omitempty"`
+}
+
+type virtualMeta struct {
+	UI virtualUI `json:"ui"`
+}
+
+// virtualUI mirrors meta.ui, which controls how the component is shown in
+// the app and the web UI. The device stores meta as a whole, so it is always
+// sent complete.
+type virtualUI struct {
+	View   *string         `json:"view,omitempty"`
+	Icon   *string         `json:"icon,omitempty"`
+	Unit   *string         `json:"unit,omitempty"`
+	Step   *float64        `json:"step,omitempty"`
+	Titles json.RawMessage `json:"titles,omitempty"`
+}
+
+// virtualSchemaAttributes returns the attributes shared by the virtual
+// components.
+func virtualSchemaAttributes(component string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"ip": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The IP address of the Shelly device.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"id": schema.Int32Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("The ID of the virtual %s component, from %d to %d. Assigned by the device if not set.", component, dynamicMinID, dynamicMaxID),
+			PlanModifiers: []planmodifier.Int32{
+				int32planmodifier.UseStateForUnknown(),
+				int32planmodifier.RequiresReplaceIfConfigured(),
+			},
+			Validators: []validator.Int32{
+				int32validator.Between(dynamicMinID, dynamicMaxID),
+			},
+		},
+		"name": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("Name of the %s instance.", component),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"icon": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "URL of the icon shown in the app and the web UI.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// virtualViewAttribute returns the attribute selecting how the component is
+// shown, one of views.
+func virtualViewAttribute(views ...string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: fmt.Sprintf("How the component is shown in the app and the web UI. One of `%s`.", strings.Join(views, "`, `")),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.String{
+			stringvalidator.OneOf(views...),
+		},
+	}
+}
+
+// virtualPersistedAttribute returns the attribute selecting whether the
+// value survives a reboot.
+func virtualPersistedAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "True if the value is kept across reboots. Otherwise it is reset to `default_value`.",
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func virtualConfigFromPlan(plan *virtualComponentResourceModel, ui virtualUI) virtualConfig {
+	ui.Icon = stringPointer(plan.Icon)
+	return virtualConfig{
+		Name: stringPointer(plan.Name),
+		Meta: &virtualMeta{UI: ui},
+	}
+}
+
+// setVirtualState sets the shared attributes of state from config and
+// returns its meta.ui.
+func setVirtualState(state *virtualComponentResourceModel, config virtualConfig) virtualUI {
+	var ui virtualUI
+	if config.Meta != nil {
+		ui = config.Meta.UI
+	}
+	state.Name = types.StringPointerValue(config.Name)
+	state.Icon = types.StringPointerValue(ui.Icon)
+	return ui
+}
+
+// addVirtualComponent creates the component with config and records its ID
+// in plan. component is the RPC namespace, e.g. Boolean.
+func addVirtualComponent(client *resty.Client, plan *virtualComponentResourceModel, component string, config any) error {
+	params := map[string]any{
+		"type":   strings.ToLower(component),
+		"config": config,
+	}
+	if !plan.ID.IsNull() && !plan.ID.IsUnknown() {
+		params["id"] = plan.ID.ValueInt32()
+	}
+	var result struct {
+		ID int32 `json:"id"`
+	}
+	if err := callRPC(client, "Virtual.Add", params, &result); err != nil {
+		return err
+	}
+	plan.ID = types.Int32Value(result.ID)
+	return nil
+}
+
+// recordVirtualComponent saves the IP and ID of a component that was just
+// added in state, so that it is not leaked if a later step of Create fails.
+func recordVirtualComponent(ctx context.Context, state *tfsdk.State, plan *virtualComponentResourceModel) diag.Diagnostics {
+	diags := state.SetAttribute(ctx, path.Root("ip"), plan.IP)
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	return diags
+}
+
+func getVirtualConfig(client *resty.Client, state *virtualComponentResourceModel, component string, out any) error {
+	return callRPC(client, component+".GetConfig", map[string]any{"id": state.ID.ValueInt32()}, out)
+}
+
+func setVirtualConfig(client *resty.Client, plan *virtualComponentResourceModel, component string, config any) error {
+	return callRPC(client, component+".SetConfig", map[string]any{"id": plan.ID.ValueInt32(), "config": config}, nil)
+}
+
+// deleteVirtualComponent deletes the component. Components that are gone
+// already are not an error.
+func deleteVirtualComponent(client *resty.Client, state *virtualComponentResourceModel, component string) error {
+	key := fmt.Sprintf("%s:%d", strings.ToLower(component), state.ID.ValueInt32())
+	if err := callRPC(client, "Virtual.Delete", map[string]any{"key": key}, nil); err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
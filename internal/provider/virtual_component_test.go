@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeVirtualDevice answers Virtual.Add, Virtual.Delete and the GetConfig /
+// SetConfig methods of virtual components, storing configs by key. The
+// members of groups are kept in values. With failGet set, GetConfig fails.
+type fakeVirtualDevice struct {
+	configs map[string]json.RawMessage
+	values  map[string]json.RawMessage
+	nextID  int32
+	failGet bool
+}
+
+func (d *fakeVirtualDevice) handle(method string, raw json.RawMessage) (any, *rpcError) {
+	var params struct {
+		Type   string          `json:"type"`
+		ID     *int32          `json:"id"`
+		Key    string          `json:"key"`
+		Config json.RawMessage `json:"config"`
+		Value  json.RawMessage `json:"value"`
+	}
+	_ = json.Unmarshal(raw, &params)
+
+	notFound := &rpcError{Code: rpcErrInvalidArgument, Message: "not found"}
+	component, name, _ := strings.Cut(method, ".")
+	switch {
+	case method == "Virtual.Add":
+		id := d.nextID
+		if params.ID != nil {
+			id = *params.ID
+		} else {
+			d.nextID++
+		}
+		d.configs[fmt.Sprintf("%s:%d", params.Type, id)] = params.Config
+		return map[string]any{"id": id}, nil
+	case method == "Virtual.Delete":
+		if _, ok := d.configs[params.Key]; !ok {
+			return nil, notFound
+		}
+		delete(d.configs, params.Key)
+		delete(d.values, params.Key)
+		return nil, nil
+	case name == "GetConfig" || name == "SetConfig" || method == "Group.Set" || method == "Group.GetStatus":
+		key := fmt.Sprintf("%s:%d", strings.ToLower(component), *params.ID)
+		if _, ok := d.configs[key]; !ok {
+			return nil, notFound
+		}
+		switch {
+		case name == "GetConfig" && d.failGet:
+			return nil, &rpcError{Code: 500, Message: "internal error"}
+		case method == "Group.Set":
+			d.values[key] = params.Value
+			return nil, nil
+		case method == "Group.GetStatus":
+			return map[string]any{"value": d.values[key]}, nil
+		}
+		if name == "SetConfig" {
+			d.configs[key] = params.Config
+			return map[string]any{"restart_required": false}, nil
+		}
+		return d.configs[key], nil
+	}
+	return nil, nil
+}
+
+func newFakeVirtualDevice() *fakeVirtualDevice {
+	return &fakeVirtualDevice{configs: map[string]json.RawMessage{}, values: map[string]json.RawMessage{}, nextID: dynamicMinID}
+}
+
+func TestVirtualBoolean(t *testing.T) {
+	ctx := context.Background()
+	device := newFakeVirtualDevice()
+	client := newFakeDevice(t, device.handle)
+
+	plan := virtualBooleanResourceModel{
+		virtualComponentResourceModel: virtualComponentResourceModel{
+			ID:   types.Int32Unknown(),
+			Name: types.StringValue("Away"),
+			Icon: types.StringUnknown(),
+		},
+		View:         types.StringValue("toggle"),
+		Titles:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Home"), types.StringValue("Away")}),
+		Persisted:    types.BoolValue(true),
+		DefaultValue: types.BoolUnknown(),
+	}
+	var diags diag.Diagnostics
+	config := virtualBooleanConfigFromPlan(ctx, &plan, &diags)
+	require.False(t, diags.HasError())
+	require.NoError(t, addVirtualComponent(client, &plan.virtualComponentResourceModel, "Boolean", config))
+	require.Equal(t, int32(dynamicMinID), plan.ID.ValueInt32())
+	require.JSONEq(t, `{"name": "Away", "persisted": true, "meta": {"ui": {"view": "toggle", "titles": ["Home", "Away"]}}}`,
+		string(device.configs["boolean:200"]))
+
+	state := virtualBooleanResourceModel{virtualComponentResourceModel: virtualComponentResourceModel{ID: plan.ID}}
+	diags, err := readVirtualBooleanConfig(ctx, client, &state)
+	require.NoError(t, err)
+	require.False(t, diags.HasError())
+	require.Equal(t, "Away", state.Name.ValueString())
+	require.True(t, state.Icon.IsNull())
+	require.Equal(t, plan.Titles, state.Titles)
+	require.True(t, state.DefaultValue.IsNull())
+
+	require.NoError(t, deleteVirtualComponent(client, &state.virtualComponentResourceModel, "Boolean"))
+	require.Empty(t, device.configs)
+	// Deleting again is not an error, and reading reports the component gone.
+	require.NoError(t, deleteVirtualComponent(client, &state.virtualComponentResourceModel, "Boolean"))
+	_, err = readVirtualBooleanConfig(ctx, client, &state)
+	require.True(t, isNotFound(err))
+}
+
+func TestAddVirtualComponentWithID(t *testing.T) {
+	device := newFakeVirtualDevice()
+	client := newFakeDevice(t, device.handle)
+
+	plan := virtualComponentResourceModel{ID: types.Int32Value(250)}
+	require.NoError(t, addVirtualComponent(client, &plan, "Number", virtualNumberConfig{}))
+	require.Equal(t, int32(250), plan.ID.ValueInt32())
+	require.Contains(t, device.configs, "number:250")
+}
+
+func TestVirtualGroup(t *testing.T) {
+	ctx := context.Background()
+	device := newFakeVirtualDevice()
+	client := newFakeDevice(t, device.handle)
+
+	plan := virtualGroupResourceModel{
+		virtualComponentResourceModel: virtualComponentResourceModel{
+			ID:   types.Int32Unknown(),
+			Name: types.StringValue("Heating"),
+			Icon: types.StringUnknown(),
+		},
+		Members: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("boolean:200"), types.StringValue("number:201")}),
+	}
+	require.NoError(t, addVirtualComponent(client, &plan.virtualComponentResourceModel, "Group", virtualGroupConfigFromPlan(&plan)))
+	var diags diag.Diagnostics
+	setVirtualGroupMembers(ctx, client, &plan, &diags)
+	require.False(t, diags.HasError(), diags)
+	require.JSONEq(t, `["boolean:200", "number:201"]`, string(device.values["group:200"]))
+
+	state := virtualGroupResourceModel{virtualComponentResourceModel: virtualComponentResourceModel{ID: plan.ID}}
+	diags, err := readVirtualGroupConfig(ctx, client, &state)
+	require.NoError(t, err)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "Heating", state.Name.ValueString())
+	require.Equal(t, plan.Members, state.Members)
+
+	// Unknown members are left to the device, an empty list clears them.
+	plan.Members = types.ListUnknown(types.StringType)
+	setVirtualGroupMembers(ctx, client, &plan, &diags)
+	require.Contains(t, string(device.values["group:200"]), "boolean:200")
+	plan.Members = types.ListValueMust(types.StringType, []attr.Value{})
+	setVirtualGroupMembers(ctx, client, &plan, &diags)
+	require.False(t, diags.HasError(), diags)
+	diags, err = readVirtualGroupConfig(ctx, client, &state)
+	require.NoError(t, err)
+	require.False(t, diags.HasError(), diags)
+	require.Empty(t, state.Members.Elements())
+	require.False(t, state.Members.IsNull())
+
+	require.NoError(t, deleteVirtualComponent(client, &state.virtualComponentResourceModel, "Group"))
+	require.Empty(t, device.configs)
+}
+
+func TestVirtualButton(t *testing.T) {
+	ctx := context.Background()
+	device := newFakeVirtualDevice()
+	client := newFakeDevice(t, device.handle)
+
+	plan := virtualButtonResourceModel{
+		virtualComponentResourceModel: virtualComponentResourceModel{
+			ID:   types.Int32Value(205),
+			Name: types.StringValue("Doorbell"),
+			Icon: types.StringNull(),
+		},
+	}
+	require.NoError(t, addVirtualComponent(client, &plan.virtualComponentResourceModel, "Button", virtualButtonConfigFromPlan(&plan)))
+	require.JSONEq(t, `{"name": "Doorbell", "meta": {"ui": {"view": "button"}}}`, string(device.configs["button:205"]))
+
+	plan.Name = types.StringValue("Gate")
+	require.NoError(t, setVirtualConfig(client, &plan.virtualComponentResourceModel, "Button", virtualButtonConfigFromPlan(&plan)))
+
+	state := virtualButtonResourceModel{virtualComponentResourceModel: virtualComponentResourceModel{ID: plan.ID}}
+	diags, err := readVirtualButtonConfig(ctx, client, &state)
+	require.NoError(t, err)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "Gate", state.Name.ValueString())
+	require.True(t, state.Icon.IsNull())
+
+	require.NoError(t, deleteVirtualComponent(client, &state.virtualComponentResourceModel, "Button"))
+	_, err = readVirtualButtonConfig(ctx, client, &state)
+	require.True(t, isNotFound(err))
+}
+
+func TestVirtualNumberValidateConfig(t *testing.T) {
+	for name, tc := range map[string]struct {
+		min, max tftypes.Value
+		valid    bool
+	}{
+		"unset":     {tftypes.NewValue(tftypes.Number, nil), tftypes.NewValue(tftypes.Number, nil), true},
+		"only min":  {tftypes.NewValue(tftypes.Number, 10), tftypes.NewValue(tftypes.Number, nil), true},
+		"unknown":   {tftypes.NewValue(tftypes.Number, 10), tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), true},
+		"ascending": {tftypes.NewValue(tftypes.Number, 0), tftypes.NewValue(tftypes.Number, 100), true},
+		"equal":     {tftypes.NewValue(tftypes.Number, 50), tftypes.NewValue(tftypes.Number, 50), false},
+		"reversed":  {tftypes.NewValue(tftypes.Number, 100), tftypes.NewValue(tftypes.Number, 0), false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res := NewVirtualNumberResource()
+			req := resource.ValidateConfigRequest{Config: resourceConfig(t, res, map[string]tftypes.Value{"min": tc.min, "max": tc.max})}
+			var resp resource.ValidateConfigResponse
+			res.(resource.ResourceWithValidateConfig).ValidateConfig(context.Background(), req, &resp)
+			require.Equal(t, !tc.valid, resp.Diagnostics.HasError(), resp.Diagnostics)
+			if !tc.valid {
+				require.Equal(t, path.Root("max"), resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path())
+			}
+		})
+	}
+}
+
+func TestVirtualEnumValidateConfig(t *testing.T) {
+	listType := tftypes.List{ElementType: tftypes.String}
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	options := tftypes.NewValue(listType, []tftypes.Value{tftypes.NewValue(tftypes.String, "eco"), tftypes.NewValue(tftypes.String, "comfort")})
+	titles := func(keys ...string) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for _, key := range keys {
+			values[key] = tftypes.NewValue(tftypes.String, strings.ToUpper(key))
+		}
+		return tftypes.NewValue(mapType, values)
+	}
+	for name, tc := range map[string]struct {
+		options, titles, defaultValue tftypes.Value
+		invalid                       *path.Path
+	}{
+		"unset": {options, tftypes.NewValue(mapType, nil), tftypes.NewValue(tftypes.String, nil), nil},
+		"valid": {options, titles("eco", "comfort"), tftypes.NewValue(tftypes.String, "eco"), nil},
+		"unknown options": {
+			tftypes.NewValue(listType, tftypes.UnknownValue), titles("away"), tftypes.NewValue(tftypes.String, "away"), nil,
+		},
+		"default not an option": {
+			options, tftypes.NewValue(mapType, nil), tftypes.NewValue(tftypes.String, "away"), pathPointer(path.Root("default_value")),
+		},
+		"title not an option": {
+			options, titles("eco", "away"), tftypes.NewValue(tftypes.String, nil), pathPointer(path.Root("titles").AtMapKey("away")),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res := NewVirtualEnumResource()
+			req := resource.ValidateConfigRequest{Config: resourceConfig(t, res, map[string]tftypes.Value{
+				"options":       tc.options,
+				"titles":        tc.titles,
+				"default_value": tc.defaultValue,
+			})}
+			var resp resource.ValidateConfigResponse
+			res.(resource.ResourceWithValidateConfig).ValidateConfig(context.Background(), req, &resp)
+			if tc.invalid == nil {
+				require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				return
+			}
+			require.Len(t, resp.Diagnostics.Errors(), 1)
+			require.Equal(t, *tc.invalid, resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path())
+		})
+	}
+}
+
+func pathPointer(p path.Path) *path.Path {
+	return &p
+}
+
+func TestVirtualComponentCreateKeepsStateOnFailedRead(t *testing.T) {
+	options := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "eco")})
+	for name, res := range map[string]resource.Resource{
+		"boolean": NewVirtualBooleanResource(),
+		"button":  NewVirtualButtonResource(),
+		"enum":    NewVirtualEnumResource(),
+		"group":   NewVirtualGroupResource(),
+		"number":  NewVirtualNumberResource(),
+		"text":    NewVirtualTextResource(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			device := newFakeVirtualDevice()
+			device.failGet = true
+			ip := fakeDeviceIP(t, device.handle)
+
+			values := map[string]tftypes.Value{
+				"ip": tftypes.NewValue(tftypes.String, ip),
+				"id": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			}
+			if name == "enum" {
+				values["options"] = options
+			}
+			config := resourceConfig(t, res, values)
+			resp := resource.CreateResponse{State: tfsdk.State{Schema: config.Schema, Raw: tftypes.NewValue(config.Raw.Type(), nil)}}
+			res.Create(ctx, resource.CreateRequest{Config: config, Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}}, &resp)
+			require.True(t, resp.Diagnostics.HasError())
+			require.Contains(t, device.configs, name+":200")
+
+			// The component was added, so it must not be forgotten.
+			var state virtualComponentResourceModel
+			require.False(t, resp.State.GetAttribute(ctx, path.Root("ip"), &state.IP).HasError())
+			require.False(t, resp.State.GetAttribute(ctx, path.Root("id"), &state.ID).HasError())
+			require.Equal(t, ip, state.IP.ValueString())
+			require.Equal(t, int32(dynamicMinID), state.ID.ValueInt32())
+		})
+	}
+}
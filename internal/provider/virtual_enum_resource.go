@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"resty.dev/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &virtualEnumResource{}
+	_ resource.ResourceWithConfigure      = &virtualEnumResource{}
+	_ resource.ResourceWithImportState    = &virtualEnumResource{}
+	_ resource.ResourceWithValidateConfig = &virtualEnumResource{}
+)
+
+func NewVirtualEnumResource() resource.Resource {
+	return &virtualEnumResource{}
+}
+
+type virtualEnumResourceModel struct {
+	virtualComponentResourceModel
+	View         types.String `tfsdk:"view"`
+	Options      types.List   `tfsdk:"options"`
+	Titles       types.Map    `tfsdk:"titles"`
+	Persisted    types.Bool   `tfsdk:"persisted"`
+	DefaultValue types.String `tfsdk:"default_value"`
+}
+
+// virtualEnumConfig mirrors the config object of Enum.GetConfig /
+// Enum.SetConfig.
+type virtualEnumConfig struct {
+	virtualConfig
+	Options      []string `json:"options,omitempty"`
+	Persisted    *bool    `json:"persisted,omitempty"`
+	DefaultValue *string  `json:"default_value,omitempty"`
+}
+
+type virtualEnumResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *virtualEnumResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_enum"
+}
+
+func (c *virtualEnumResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *virtualEnumResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := virtualSchemaAttributes("Enum")
+	attributes["view"] = virtualViewAttribute("dropdown", "label")
+	attributes["options"] = schema.ListAttribute{
+		Required:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "The values to choose from.",
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.UniqueValues(),
+			listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+		},
+	}
+	attributes["titles"] = schema.MapAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "Titles shown for the options, keyed by option.",
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["persisted"] = virtualPersistedAttribute()
+	attributes["default_value"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Option selected after a reboot if the value is not persisted.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a virtual Enum component, e.g. a mode selector in the app that scripts can read.",
+		Attributes:          attributes,
+	}
+}
+
+// ValidateConfig checks that default_value and the keys of titles are
+// options.
+func (c *virtualEnumResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config virtualEnumResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Options.IsUnknown() {
+		return
+	}
+	var options []types.String
+	resp.Diagnostics.Append(config.Options.ElementsAs(ctx, &options, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	values := make([]string, 0, len(options))
+	for _, option := range options {
+		if option.IsUnknown() {
+			return
+		}
+		values = append(values, option.ValueString())
+	}
+
+	if !config.DefaultValue.IsNull() && !config.DefaultValue.IsUnknown() && !slices.Contains(values, config.DefaultValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("default_value"), "Invalid default value",
+			fmt.Sprintf("default_value %q is not one of the options.", config.DefaultValue.ValueString()))
+	}
+	if !config.Titles.IsNull() && !config.Titles.IsUnknown() {
+		for option := range config.Titles.Elements() {
+			if !slices.Contains(values, option) {
+				resp.Diagnostics.AddAttributeError(path.Root("titles").AtMapKey(option), "Invalid title",
+					fmt.Sprintf("%q is not one of the options.", option))
+			}
+		}
+	}
+}
+
+func readVirtualEnumConfig(ctx context.Context, client *resty.Client, state *virtualEnumResourceModel) (diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+	var config virtualEnumConfig
+	if err := getVirtualConfig(client, &state.virtualComponentResourceModel, "Enum", &config); err != nil {
+		return diags, err
+	}
+
+	ui := setVirtualState(&state.virtualComponentResourceModel, config.virtualConfig)
+	var d diag.Diagnostics
+	state.View = types.StringPointerValue(ui.View)
+	state.Persisted = types.BoolPointerValue(config.Persisted)
+	state.DefaultValue = types.StringPointerValue(config.DefaultValue)
+	state.Options, d = types.ListValueFrom(ctx, types.StringType, config.Options)
+	diags.Append(d...)
+	var titles map[string]string
+	if json.Unmarshal(ui.Titles, &titles) == nil && titles != nil {
+		state.Titles, d = types.MapValueFrom(ctx, types.StringType, titles)
+		diags.Append(d...)
+	} else {
+		state.Titles = types.MapNull(types.StringType)
+	}
+	return diags, nil
+}
+
+func virtualEnumConfigFromPlan(ctx context.Context, plan *virtualEnumResourceModel, diags *diag.Diagnostics) virtualEnumConfig {
+	ui := virtualUI{View: stringPointer(plan.View)}
+	if !plan.Titles.IsNull() && !plan.Titles.IsUnknown() {
+		var titles map[string]string
+		diags.Append(plan.Titles.ElementsAs(ctx, &titles, false)...)
+		ui.Titles, _ = json.Marshal(titles)
+	}
+	config := virtualEnumConfig{
+		virtualConfig: virtualConfigFromPlan(&plan.virtualComponentResourceModel, ui),
+		Persisted:     boolPointer(plan.Persisted),
+		DefaultValue:  stringPointer(plan.DefaultValue),
+	}
+	diags.Append(plan.Options.ElementsAs(ctx, &config.Options, false)...)
+	return config
+}
+
+func (c *virtualEnumResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state virtualEnumResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	diags, err := readVirtualEnumConfig(ctx, client, &state)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Enum config", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualEnumResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan virtualEnumResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	config := virtualEnumConfigFromPlan(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := addVirtualComponent(client, &plan.virtualComponentResourceModel, "Enum", config); err != nil {
+		resp.Diagnostics.AddError("Failed to add Enum component", err.Error())
+		return
+	}
+	// Record the component right away so that it is not leaked if reading
+	// it back fails.
+	resp.Diagnostics.Append(recordVirtualComponent(ctx, &resp.State, &plan.virtualComponentResourceModel)...)
+	diags, err := readVirtualEnumConfig(ctx, client, &plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Enum config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualEnumResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan virtualEnumResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	config := virtualEnumConfigFromPlan(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := setVirtualConfig(client, &plan.virtualComponentResourceModel, "Enum", config); err != nil {
+		resp.Diagnostics.AddError("Failed to set Enum config", err.Error())
+		return
+	}
+	diags, err := readVirtualEnumConfig(ctx, client, &plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Enum config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualEnumResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importDynamicComponentState(ctx, req, resp, "Enum")
+}
+
+func (c *virtualEnumResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state virtualEnumResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	if err := deleteVirtualComponent(client, &state.virtualComponentResourceModel, "Enum"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete Enum component", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"resty.dev/v3"
+)
+
+// componentKeyRegexp matches component keys such as boolean:200.
+var componentKeyRegexp = regexp.MustCompile(`^[a-z0-9_]+:[0-9]+$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &virtualGroupResource{}
+	_ resource.ResourceWithConfigure   = &virtualGroupResource{}
+	_ resource.ResourceWithImportState = &virtualGroupResource{}
+)
+
+func NewVirtualGroupResource() resource.Resource {
+	return &virtualGroupResource{}
+}
+
+type virtualGroupResourceModel struct {
+	virtualComponentResourceModel
+	Members types.List `tfsdk:"members"`
+}
+
+// virtualGroupConfig mirrors the config object of Group.GetConfig /
+// Group.SetConfig. The members are the value of the group, set with
+// Group.Set.
+type virtualGroupConfig struct {
+	virtualConfig
+}
+
+type virtualGroupResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *virtualGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_group"
+}
+
+func (c *virtualGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *virtualGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := virtualSchemaAttributes("Group")
+	attributes["members"] = schema.ListAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "Keys of the components shown together in the group, e.g. `boolean:200`.",
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.List{
+			listvalidator.UniqueValues(),
+			listvalidator.ValueStringsAre(stringvalidator.RegexMatches(componentKeyRegexp, "must be a component key such as boolean:200")),
+		},
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a virtual Group component, which shows related components together in the app.",
+		Attributes:          attributes,
+	}
+}
+
+func readVirtualGroupConfig(ctx context.Context, client *resty.Client, state *virtualGroupResourceModel) (diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+	var config virtualGroupConfig
+	if err := getVirtualConfig(client, &state.virtualComponentResourceModel, "Group", &config); err != nil {
+		return diags, err
+	}
+	var status struct {
+		Value []string `json:"value"`
+	}
+	if err := callRPC(client, "Group.GetStatus", map[string]any{"id": state.ID.ValueInt32()}, &status); err != nil {
+		return diags, err
+	}
+
+	setVirtualState(&state.virtualComponentResourceModel, config.virtualConfig)
+	if status.Value == nil {
+		status.Value = []string{}
+	}
+	state.Members, diags = types.ListValueFrom(ctx, types.StringType, status.Value)
+	return diags, nil
+}
+
+func virtualGroupConfigFromPlan(plan *virtualGroupResourceModel) virtualGroupConfig {
+	return virtualGroupConfig{
+		virtualConfig: virtualConfigFromPlan(&plan.virtualComponentResourceModel, virtualUI{}),
+	}
+}
+
+// setVirtualGroupMembers sets the members of the group, unless they are left
+// to the device.
+func setVirtualGroupMembers(ctx context.Context, client *resty.Client, plan *virtualGroupResourceModel, diags *diag.Diagnostics) {
+	if plan.Members.IsNull() || plan.Members.IsUnknown() {
+		return
+	}
+	var members []string
+	diags.Append(plan.Members.ElementsAs(ctx, &members, false)...)
+	if diags.HasError() {
+		return
+	}
+	if err := callRPC(client, "Group.Set", map[string]any{"id": plan.ID.ValueInt32(), "value": members}, nil); err != nil {
+		diags.AddError("Failed to set Group members", err.Error())
+	}
+}
+
+func (c *virtualGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state virtualGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	diags, err := readVirtualGroupConfig(ctx, client, &state)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Group config", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan virtualGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	config := virtualGroupConfigFromPlan(&plan)
+	if err := addVirtualComponent(client, &plan.virtualComponentResourceModel, "Group", config); err != nil {
+		resp.Diagnostics.AddError("Failed to add Group component", err.Error())
+		return
+	}
+	// Record the component right away so that it is not leaked if setting
+	// the members fails.
+	resp.Diagnostics.Append(recordVirtualComponent(ctx, &resp.State, &plan.virtualComponentResourceModel)...)
+	setVirtualGroupMembers(ctx, client, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags, err := readVirtualGroupConfig(ctx, client, &plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Group config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan virtualGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	config := virtualGroupConfigFromPlan(&plan)
+	if err := setVirtualConfig(client, &plan.virtualComponentResourceModel, "Group", config); err != nil {
+		resp.Diagnostics.AddError("Failed to set Group config", err.Error())
+		return
+	}
+	setVirtualGroupMembers(ctx, client, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags, err := readVirtualGroupConfig(ctx, client, &plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Group config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importDynamicComponentState(ctx, req, resp, "Group")
+}
+
+func (c *virtualGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state virtualGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	if err := deleteVirtualComponent(client, &state.virtualComponentResourceModel, "Group"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete Group component", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
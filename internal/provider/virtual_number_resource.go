@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"resty.dev/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &virtualNumberResource{}
+	_ resource.ResourceWithConfigure      = &virtualNumberResource{}
+	_ resource.ResourceWithImportState    = &virtualNumberResource{}
+	_ resource.ResourceWithValidateConfig = &virtualNumberResource{}
+)
+
+func NewVirtualNumberResource() resource.Resource {
+	return &virtualNumberResource{}
+}
+
+type virtualNumberResourceModel struct {
+	virtualComponentResourceModel
+	View         types.String  `tfsdk:"view"`
+	Unit         types.String  `tfsdk:"unit"`
+	Step         types.Float64 `tfsdk:"step"`
+	Min          types.Float64 `tfsdk:"min"`
+	Max          types.Float64 `tfsdk:"max"`
+	Persisted    types.Bool    `tfsdk:"persisted"`
+	DefaultValue types.Float64 `tfsdk:"default_value"`
+}
+
+// virtualNumberConfig mirrors the config object of Number.GetConfig /
+// Number.SetConfig.
+type virtualNumberConfig struct {
+	virtualConfig
+	Min          *float64 `json:"min,omitempty"`
+	Max          *float64 `json:"max,omitempty"`
+	Persisted    *bool    `json:"persisted,omitempty"`
+	DefaultValue *float64 `json:"default_value,omitempty"`
+}
+
+type virtualNumberResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *virtualNumberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_number"
+}
+
+func (c *virtualNumberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *virtualNumberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := virtualSchemaAttributes("Number")
+	attributes["view"] = virtualViewAttribute("field", "slider", "progressbar", "label")
+	attributes["unit"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Unit shown after the value, e.g. `°C`.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["step"] = schema.Float64Attribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Step of the slider or field.",
+		PlanModifiers: []planmodifier.Float64{
+			float64planmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.Float64{
+			float64validator.AtLeast(0),
+		},
+	}
+	attributes["min"] = signedFloat64Attribute("Lowest accepted value.")
+	attributes["max"] = signedFloat64Attribute("Highest accepted value.")
+	attributes["persisted"] = virtualPersistedAttribute()
+	attributes["default_value"] = signedFloat64Attribute("Value after a reboot if the value is not persisted.")
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a virtual Number component, e.g. a slider in the app that sets a script parameter.",
+		Attributes:          attributes,
+	}
+}
+
+func (c *virtualNumberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config virtualNumberResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.Min.IsNull() && !config.Min.IsUnknown() && !config.Max.IsNull() && !config.Max.IsUnknown() &&
+		config.Min.ValueFloat64() >= config.Max.ValueFloat64() {
+		resp.Diagnostics.AddAttributeError(path.Root("max"), "Invalid range",
+			fmt.Sprintf("max (%g) must be greater than min (%g).", config.Max.ValueFloat64(), config.Min.ValueFloat64()))
+	}
+}
+
+func readVirtualNumberConfig(ctx context.Context, client *resty.Client, state *virtualNumberResourceModel) (diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+	var config virtualNumberConfig
+	if err := getVirtualConfig(client, &state.virtualComponentResourceModel, "Number", &config); err != nil {
+		return diags, err
+	}
+
+	ui := setVirtualState(&state.virtualComponentResourceModel, config.virtualConfig)
+	state.View = types.StringPointerValue(ui.View)
+	state.Unit = types.StringPointerValue(ui.Unit)
+	state.Step = types.Float64PointerValue(ui.Step)
+	state.Min = types.Float64PointerValue(config.Min)
+	state.Max = types.Float64PointerValue(config.Max)
+	state.Persisted = types.BoolPointerValue(config.Persisted)
+	state.DefaultValue = types.Float64PointerValue(config.DefaultValue)
+	return diags, nil
+}
+
+func virtualNumberConfigFromPlan(plan *virtualNumberResourceModel) virtualNumberConfig {
+	ui := virtualUI{
+		View: stringPointer(plan.View),
+		Unit: stringPointer(plan.Unit),
+		Step: float64Pointer(plan.Step),
+	}
+	return virtualNumberConfig{
+		virtualConfig: virtualConfigFromPlan(&plan.virtualComponentResourceModel, ui),
+		Min:           float64Pointer(plan.Min),
+		Max:           float64Pointer(plan.Max),
+		Persisted:     boolPointer(plan.Persisted),
+		DefaultValue:  float64Pointer(plan.DefaultValue),
+	}
+}
+
+func (c *virtualNumberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state virtualNumberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	diags, err := readVirtualNumberConfig(ctx, client, &state)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Number config", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualNumberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan virtualNumberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	config := virtualNumberConfigFromPlan(&plan)
+	if err := addVirtualComponent(client, &plan.virtualComponentResourceModel, "Number", config); err != nil {
+		resp.Diagnostics.AddError("Failed to add Number component", err.Error())
+		return
+	}
+	// Record the component right away so that it is not leaked if reading
+	// it back fails.
+	resp.Diagnostics.Append(recordVirtualComponent(ctx, &resp.State, &plan.virtualComponentResourceModel)...)
+	diags, err := readVirtualNumberConfig(ctx, client, &plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Number config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualNumberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan virtualNumberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	config := virtualNumberConfigFromPlan(&plan)
+	if err := setVirtualConfig(client, &plan.virtualComponentResourceModel, "Number", config); err != nil {
+		resp.Diagnostics.AddError("Failed to set Number config", err.Error())
+		return
+	}
+	diags, err := readVirtualNumberConfig(ctx, client, &plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Number config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualNumberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importDynamicComponentState(ctx, req, resp, "Number")
+}
+
+func (c *virtualNumberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state virtualNumberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	if err := deleteVirtualComponent(client, &state.virtualComponentResourceModel, "Number"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete Number component", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"resty.dev/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &virtualTextResource{}
+	_ resource.ResourceWithConfigure   = &virtualTextResource{}
+	_ resource.ResourceWithImportState = &virtualTextResource{}
+)
+
+func NewVirtualTextResource() resource.Resource {
+	return &virtualTextResource{}
+}
+
+type virtualTextResourceModel struct {
+	virtualComponentResourceModel
+	View         types.String `tfsdk:"view"`
+	MaxLen       types.Int64  `tfsdk:"max_len"`
+	Persisted    types.Bool   `tfsdk:"persisted"`
+	DefaultValue types.String `tfsdk:"default_value"`
+}
+
+// virtualTextConfig mirrors the config object of Text.GetConfig /
+// Text.SetConfig.
+type virtualTextConfig struct {
+	virtualConfig
+	MaxLen       *int64  `json:"max_len,omitempty"`
+	Persisted    *bool   `json:"persisted,omitempty"`
+	DefaultValue *string `json:"default_value,omitempty"`
+}
+
+type virtualTextResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *virtualTextResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_text"
+}
+
+func (c *virtualTextResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *virtualTextResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := virtualSchemaAttributes("Text")
+	attributes["view"] = virtualViewAttribute("field", "label")
+	attributes["max_len"] = schema.Int64Attribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Maximum length of the value in bytes.",
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+	attributes["persisted"] = virtualPersistedAttribute()
+	attributes["default_value"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Value after a reboot if the value is not persisted.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a virtual Text component, e.g. a status line shown in the app or a setting for a script.",
+		Attributes:          attributes,
+	}
+}
+
+func readVirtualTextConfig(ctx context.Context, client *resty.Client, state *virtualTextResourceModel) (diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+	var config virtualTextConfig
+	if err := getVirtualConfig(client, &state.virtualComponentResourceModel, "Text", &config); err != nil {
+		return diags, err
+	}
+
+	ui := setVirtualState(&state.virtualComponentResourceModel, config.virtualConfig)
+	state.View = types.StringPointerValue(ui.View)
+	state.MaxLen = types.Int64PointerValue(config.MaxLen)
+	state.Persisted = types.BoolPointerValue(config.Persisted)
+	state.DefaultValue = types.StringPointerValue(config.DefaultValue)
+	return diags, nil
+}
+
+func virtualTextConfigFromPlan(plan *virtualTextResourceModel) virtualTextConfig {
+	return virtualTextConfig{
+		virtualConfig: virtualConfigFromPlan(&plan.virtualComponentResourceModel, virtualUI{View: stringPointer(plan.View)}),
+		MaxLen:        int64Pointer(plan.MaxLen),
+		Persisted:     boolPointer(plan.Persisted),
+		DefaultValue:  stringPointer(plan.DefaultValue),
+	}
+}
+
+func (c *virtualTextResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state virtualTextResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	diags, err := readVirtualTextConfig(ctx, client, &state)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Text config", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualTextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan virtualTextResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	config := virtualTextConfigFromPlan(&plan)
+	if err := addVirtualComponent(client, &plan.virtualComponentResourceModel, "Text", config); err != nil {
+		resp.Diagnostics.AddError("Failed to add Text component", err.Error())
+		return
+	}
+	// Record the component right away so that it is not leaked if reading
+	// it back fails.
+	resp.Diagnostics.Append(recordVirtualComponent(ctx, &resp.State, &plan.virtualComponentResourceModel)...)
+	diags, err := readVirtualTextConfig(ctx, client, &plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Text config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualTextResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan virtualTextResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	config := virtualTextConfigFromPlan(&plan)
+	if err := setVirtualConfig(client, &plan.virtualComponentResourceModel, "Text", config); err != nil {
+		resp.Diagnostics.AddError("Failed to set Text config", err.Error())
+		return
+	}
+	diags, err := readVirtualTextConfig(ctx, client, &plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to query Text config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *virtualTextResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importDynamicComponentState(ctx, req, resp, "Text")
+}
+
+func (c *virtualTextResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state virtualTextResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	if err := deleteVirtualComponent(client, &state.virtualComponentResourceModel, "Text"); err != nil {
+		resp.Diagnostics.AddError("Failed to delete Text component", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
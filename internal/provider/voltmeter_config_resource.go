@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &voltmeterConfigResource{}
+	_ resource.ResourceWithConfigure   = &voltmeterConfigResource{}
+	_ resource.ResourceWithImportState = &voltmeterConfigResource{}
+)
+
+func NewVoltmeterConfigResource() resource.Resource {
+	return &voltmeterConfigResource{}
+}
+
+type voltmeterConfigResourceModel struct {
+	sensorConfigResourceModel
+	ReportThr types.Float64           `tfsdk:"report_thr"`
+	Range     types.Int64             `tfsdk:"range"`
+	XVoltage  *voltmeterXVoltageModel `tfsdk:"xvoltage"`
+}
+
+type voltmeterXVoltageModel struct {
+	Expr types.String `tfsdk:"expr"`
+	Unit types.String `tfsdk:"unit"`
+}
+
+// voltmeterConfig mirrors the config object of Voltmeter.GetConfig /
+// Voltmeter.SetConfig.
+type voltmeterConfig struct {
+	sensorConfig
+	ReportThr *float64                 `json:"report_thr,omitempty"`
+	Range     *int64                   `json:"range,omitempty"`
+	XVoltage  *voltmeterXVoltageConfig `json:"xvoltage,omitempty"`
+}
+
+type voltmeterXVoltageConfig struct {
+	Expr *string `json:"expr,omitempty"`
+	Unit *string `json:"unit,omitempty"`
+}
+
+type voltmeterConfigResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *voltmeterConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_voltmeter_config"
+}
+
+func (c *voltmeterConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *voltmeterConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := sensorSchemaAttributes("Voltmeter")
+	attributes["report_thr"] = optionalFloat64Attribute("Voltage change in volts that triggers a status update.")
+	attributes["range"] = schema.Int64Attribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Measurement range, as an index into the ranges supported by the hardware.",
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["xvoltage"] = schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: "Transformation of the measured voltage into another quantity, e.g. a tank level.",
+		Attributes: map[string]schema.Attribute{
+			"expr": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "JavaScript expression evaluated on the measured value `x`, e.g. `x*10`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"unit": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Unit of the transformed value, up to 20 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(20),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func readVoltmeterConfig(rpc deviceRPC, state *voltmeterConfigResourceModel) error {
+	var config voltmeterConfig
+	if err := getSensorConfig(rpc, &state.sensorConfigResourceModel, "Voltmeter", &config); err != nil {
+		return err
+	}
+
+	state.Name = types.StringPointerValue(config.Name)
+	state.ReportThr = types.Float64PointerValue(config.ReportThr)
+	state.Range = types.Int64PointerValue(config.Range)
+	if state.XVoltage != nil {
+		if config.XVoltage == nil {
+			config.XVoltage = &voltmeterXVoltageConfig{}
+		}
+		state.XVoltage.Expr = types.StringPointerValue(config.XVoltage.Expr)
+		state.XVoltage.Unit = types.StringPointerValue(config.XVoltage.Unit)
+	}
+	return nil
+}
+
+func (c *voltmeterConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state voltmeterConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rpc, release := httpDeviceRPC(c.credentials, state.IP.ValueString())
+	defer release()
+	if err := readVoltmeterConfig(rpc, &state); err != nil {
+		if deviceAsleep(state.Sleepy, err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to query Voltmeter config", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func setVoltmeterConfig(rpc deviceRPC, plan *voltmeterConfigResourceModel, diags *diag.Diagnostics) error {
+	config := voltmeterConfig{
+		sensorConfig: sensorConfigFromPlan(&plan.sensorConfigResourceModel),
+		ReportThr:    float64Pointer(plan.ReportThr),
+		Range:        int64Pointer(plan.Range),
+	}
+	if plan.XVoltage != nil {
+		config.XVoltage = &voltmeterXVoltageConfig{
+			Expr: stringPointer(plan.XVoltage.Expr),
+			Unit: stringPointer(plan.XVoltage.Unit),
+		}
+	}
+	return setSensorConfig(rpc, &plan.sensorConfigResourceModel, "Voltmeter", config, diags)
+}
+
+func (c *voltmeterConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan voltmeterConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rpc, release := connectSensor(ctx, c.credentials, &plan.sensorConfigResourceModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer release()
+	if err := setVoltmeterConfig(rpc, &plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readVoltmeterConfig(rpc, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query Voltmeter config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *voltmeterConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan voltmeterConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	rpc, release := connectSensor(ctx, c.credentials, &plan.sensorConfigResourceModel, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer release()
+	if err := setVoltmeterConfig(rpc, &plan, &resp.Diagnostics); err != nil {
+		return
+	}
+	if err := readVoltmeterConfig(rpc, &plan); err != nil {
+		resp.Diagnostics.AddError("Failed to query Voltmeter config", err.Error())
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *voltmeterConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importSensorState(ctx, req, resp, "Voltmeter")
+}
+
+func (c *voltmeterConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// defaultWakeTimeout is how long to wait for a sleepy device to wake up
+	// unless configured otherwise.
+	defaultWakeTimeout = 300
+	// wakePollInterval is short because battery devices only stay awake for
+	// a few seconds.
+	wakePollInterval = time.Second
+	// wsSource identifies the provider in RPC frames sent over WebSocket.
+	wsSource = "terraform-provider-shelly"
+)
+
+// waitForWake waits until the sleepy device at ip can be reached and returns
+// a deviceRPC for it, plus a function releasing it. The device is polled over
+// HTTP; if listenAddress is set, its outbound WebSocket connection to the
+// provider is accepted as well and used if it arrives first.
+func waitForWake(ctx context.Context, credentials *deviceCredentials, ip, listenAddress string, timeout time.Duration) (deviceRPC, func(), error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var arrived <-chan *wsDevice
+	if listenAddress != "" {
+		listener, err := wakeListenerFor(listenAddress)
+		if err != nil {
+			return nil, nil, err
+		}
+		arrived = listener.wait(ctx, ip)
+	}
+
+	client := newDeviceClient(credentials, ip)
+	client.SetTimeout(wakePollInterval * 2)
+	deadline := time.After(timeout)
+	for {
+		if _, err := getDeviceInfo(client); err == nil {
+			rpc := func(method string, params any, out any) error {
+				return callRPC(client, method, params, out)
+			}
+			return rpc, func() { client.Close() }, nil
+		}
+		select {
+		case device := <-arrived:
+			client.Close()
+			return device.call, func() {}, nil
+		case <-deadline:
+			client.Close()
+			return nil, nil, fmt.Errorf("device at %s did not wake up within %s", ip, timeout)
+		case <-ctx.Done():
+			client.Close()
+			return nil, nil, ctx.Err()
+		case <-time.After(wakePollInterval):
+		}
+	}
+}
+
+// wakeListener accepts the outbound WebSocket connections of sleepy devices
+// and keeps the open ones by remote IP. Listeners stay open for the lifetime
+// of the provider process, so that all resources of a device share them.
+type wakeListener struct {
+	mu      sync.Mutex
+	devices map[string]*wsDevice
+	arrived map[string]chan struct{}
+}
+
+var (
+	wakeListenersMu sync.Mutex
+	wakeListeners   = map[string]*wakeListener{}
+)
+
+// wakeListenerFor returns the listener for address, starting it if needed.
+func wakeListenerFor(address string) (*wakeListener, error) {
+	wakeListenersMu.Lock()
+	defer wakeListenersMu.Unlock()
+
+	if listener, ok := wakeListeners[address]; ok {
+		return listener, nil
+	}
+	ln, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for devices on %s: %w", address, err)
+	}
+	listener := &wakeListener{
+		devices: map[string]*wsDevice{},
+		arrived: map[string]chan struct{}{},
+	}
+	// Devices send no Origin header, so the default origin check is skipped.
+	go func() { _ = http.Serve(ln, websocket.Server{Handler: listener.handle}) }()
+	wakeListeners[address] = listener
+	return listener, nil
+}
+
+// handle registers a device connection and serves it until it is closed.
+func (l *wakeListener) handle(conn *websocket.Conn) {
+	ip, _, err := net.SplitHostPort(conn.Request().RemoteAddr)
+	if err != nil {
+		return
+	}
+	device := &wsDevice{
+		conn:    conn,
+		pending: map[int]chan rpcResponse{},
+		done:    make(chan struct{}),
+	}
+
+	l.mu.Lock()
+	l.devices[ip] = device
+	if arrived, ok := l.arrived[ip]; ok {
+		close(arrived)
+		delete(l.arrived, ip)
+	}
+	l.mu.Unlock()
+
+	device.serve()
+
+	l.mu.Lock()
+	if l.devices[ip] == device {
+		delete(l.devices, ip)
+	}
+	l.mu.Unlock()
+}
+
+// wait returns a channel that receives the connection of the device at ip
+// once it is open, or nothing if ctx is done first.
+func (l *wakeListener) wait(ctx context.Context, ip string) <-chan *wsDevice {
+	ch := make(chan *wsDevice, 1)
+	go func() {
+		for {
+			l.mu.Lock()
+			device := l.devices[ip]
+			arrived, ok := l.arrived[ip]
+			if !ok {
+				arrived = make(chan struct{})
+				l.arrived[ip] = arrived
+			}
+			l.mu.Unlock()
+
+			if device != nil {
+				ch <- device
+				return
+			}
+			select {
+			case <-arrived:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// wsRPCRequest is an RPC frame sent over WebSocket, which must name its
+// source so the device can address the response.
+type wsRPCRequest struct {
+	ID     int    `json:"id"`
+	Src    string `json:"src"`
+	Method string `json:"method"`
+	Params any    `json:"params,omitempty"`
+}
+
+// wsFrame is a frame received over WebSocket: either a response, or a
+// notification such as NotifyFullStatus, which has a method.
+type wsFrame struct {
+	rpcResponse
+	Method string `json:"method"`
+}
+
+// wsDevice is an open outbound WebSocket connection of a device.
+type wsDevice struct {
+	conn    *websocket.Conn
+	mu      sync.Mutex
+	nextID  int
+	pending map[int]chan rpcResponse
+	done    chan struct{}
+}
+
+// serve dispatches responses to the pending calls until the connection is
+// closed.
+func (d *wsDevice) serve() {
+	defer close(d.done)
+	for {
+		var frame wsFrame
+		if err := websocket.JSON.Receive(d.conn, &frame); err != nil {
+			return
+		}
+		if frame.Method != "" {
+			continue
+		}
+		d.mu.Lock()
+		ch, ok := d.pending[frame.ID]
+		delete(d.pending, frame.ID)
+		d.mu.Unlock()
+		if ok {
+			ch <- frame.rpcResponse
+		}
+	}
+}
+
+// call is a deviceRPC over the WebSocket connection.
+func (d *wsDevice) call(method string, params any, out any) error {
+	d.mu.Lock()
+	d.nextID++
+	id := d.nextID
+	ch := make(chan rpcResponse, 1)
+	d.pending[id] = ch
+	d.mu.Unlock()
+	defer func() {
+		d.mu.Lock()
+		delete(d.pending, id)
+		d.mu.Unlock()
+	}()
+
+	if err := websocket.JSON.Send(d.conn, wsRPCRequest{ID: id, Src: wsSource, Method: method, Params: params}); err != nil {
+		return err
+	}
+	select {
+	case frame := <-ch:
+		return decodeRPCResult(method, frame, out)
+	case <-d.done:
+		return fmt.Errorf("%s: device closed the connection", method)
+	case <-time.After(deviceRequestTimeout):
+		return fmt.Errorf("%s: no response within %s", method, deviceRequestTimeout)
+	}
+}
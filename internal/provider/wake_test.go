@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+func TestWakeListener(t *testing.T) {
+	listener := &wakeListener{
+		devices: map[string]*wsDevice{},
+		arrived: map[string]chan struct{}{},
+	}
+	server := httptest.NewServer(websocket.Server{Handler: listener.handle})
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	arrived := listener.wait(ctx, "127.0.0.1")
+
+	// Play the device: announce ourselves, then answer a single request.
+	conn, err := websocket.Dial("ws"+strings.TrimPrefix(server.URL, "http"), "", "http://127.0.0.1/")
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, websocket.JSON.Send(conn, map[string]any{
+		"src":    "shellyhtg3-0123456789ab",
+		"method": "NotifyFullStatus",
+		"params": map[string]any{},
+	}))
+	go func() {
+		var req wsRPCRequest
+		if err := websocket.JSON.Receive(conn, &req); err != nil {
+			return
+		}
+		_ = websocket.JSON.Send(conn, map[string]any{
+			"id":     req.ID,
+			"src":    "shellyhtg3-0123456789ab",
+			"dst":    req.Src,
+			"result": map[string]any{"id": 0, "name": req.Method},
+		})
+	}()
+
+	device := <-arrived
+	var config sensorConfig
+	require.NoError(t, device.call("Temperature.GetConfig", map[string]any{"id": 0}, &config))
+	require.NotNil(t, config.Name)
+	require.Equal(t, "Temperature.GetConfig", *config.Name)
+}
+
+func TestDecodeRPCResult(t *testing.T) {
+	var out sensorConfig
+	err := decodeRPCResult("Temperature.GetConfig", rpcResponse{Error: &rpcError{Code: 404, Message: "not found"}}, &out)
+	require.True(t, isRPCError(err))
+
+	err = decodeRPCResult("Temperature.GetConfig", rpcResponse{Result: json.RawMessage(`{"id":100}`)}, &out)
+	require.NoError(t, err)
+	require.Equal(t, 100, out.ID)
+}
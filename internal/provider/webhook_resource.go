@@ -0,0 +1,375 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"resty.dev/v3"
+)
+
+// webhookMaxURLs is the number of URLs the firmware accepts per webhook.
+// Devices do not report it, so it is fixed here.
+const webhookMaxURLs = 5
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &webhookResource{}
+	_ resource.ResourceWithConfigure   = &webhookResource{}
+	_ resource.ResourceWithImportState = &webhookResource{}
+	_ resource.ResourceWithModifyPlan  = &webhookResource{}
+)
+
+func NewWebhookResource() resource.Resource {
+	return &webhookResource{}
+}
+
+type webhookResourceModel struct {
+	IP            types.String `tfsdk:"ip"`
+	ID            types.Int32  `tfsdk:"id"`
+	CID           types.Int32  `tfsdk:"cid"`
+	Event         types.String `tfsdk:"event"`
+	Name          types.String `tfsdk:"name"`
+	Enable        types.Bool   `tfsdk:"enable"`
+	URLs          types.List   `tfsdk:"urls"`
+	Condition     types.String `tfsdk:"condition"`
+	RepeatPeriod  types.Int64  `tfsdk:"repeat_period"`
+	ActiveBetween types.List   `tfsdk:"active_between"`
+}
+
+// webhook mirrors a hook of Webhook.List and the params of Webhook.Create /
+// Webhook.Update. Null condition and active_between clear them.
+type webhook struct {
+	ID            *int32   `json:"id,omitempty"`
+	CID           int32    `json:"cid"`
+	Event         string   `json:"event"`
+	Name          *string  `json:"name"`
+	Enable        bool     `json:"enable"`
+	URLs          []string `json:"urls"`
+	Condition     *string  `json:"condition"`
+	RepeatPeriod  int64    `json:"repeat_period"`
+	ActiveBetween []string `json:"active_between"`
+}
+
+type webhookResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *webhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (c *webhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func (c *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a webhook, which calls URLs when an event such as `switch.on` occurs on the device.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the webhook, assigned by the device.",
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"cid": schema.Int32Attribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the component the event belongs to, e.g. `0` for the first switch.",
+			},
+			"event": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The event, e.g. `switch.on` or `input.button_push`. Checked against the events the device supports when planning.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name of the webhook.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"enable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "True if the webhook is enabled. Defaults to true.",
+			},
+			"urls": schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: fmt.Sprintf("URLs called when the event occurs, at most %d. This limit is fixed in the provider, since devices do not report it. They may contain `${...}` placeholders for event attributes.", webhookMaxURLs),
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, webhookMaxURLs),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"condition": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JavaScript expression on the event attributes which must be true to call the URLs, e.g. `ev.tC > 30`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"repeat_period": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				MarkdownDescription: "Minimum time in seconds between calls while the condition stays true. `0` calls only when it becomes true, `-1` calls on every event. Defaults to 0.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(-1),
+				},
+			},
+			"active_between": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Start and end of the time of day in HH:MM format during which the webhook is active, e.g. `[\"22:00\", \"06:00\"]`.",
+				Validators: []validator.List{
+					listvalidator.SizeBetween(2, 2),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(timeOfDayRegexp, "must be a time of day in HH:MM format")),
+				},
+			},
+		},
+	}
+}
+
+// supportedWebhookEvents returns the events the device accepts. Newer
+// firmware reports them as keys of types, older firmware as hook_types.
+func supportedWebhookEvents(client *resty.Client) ([]string, error) {
+	var result struct {
+		Types     map[string]json.RawMessage `json:"types"`
+		HookTypes []string                   `json:"hook_types"`
+	}
+	if err := callRPC(client, "Webhook.ListSupported", nil, &result); err != nil {
+		return nil, err
+	}
+	events := slices.Clone(result.HookTypes)
+	for event := range result.Types {
+		events = append(events, event)
+	}
+	slices.Sort(events)
+	return slices.Compact(events), nil
+}
+
+// ModifyPlan checks event against the events supported by the device.
+// Devices that cannot be reached are not checked.
+func (c *webhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan webhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.IP.IsUnknown() || plan.Event.IsUnknown() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+	client.SetTimeout(deviceRequestTimeout)
+
+	events, err := supportedWebhookEvents(client)
+	if err != nil || len(events) == 0 {
+		return
+	}
+	if !slices.Contains(events, plan.Event.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("event"), "Unsupported webhook event",
+			fmt.Sprintf("The device at %s does not support the event %q. Supported events: %s.",
+				plan.IP.ValueString(), plan.Event.ValueString(), strings.Join(events, ", ")))
+	}
+}
+
+func webhookFromPlan(ctx context.Context, plan *webhookResourceModel, diags *diag.Diagnostics) webhook {
+	hook := webhook{
+		CID:          plan.CID.ValueInt32(),
+		Event:        plan.Event.ValueString(),
+		Name:         plan.Name.ValueStringPointer(),
+		Enable:       plan.Enable.ValueBool(),
+		Condition:    plan.Condition.ValueStringPointer(),
+		RepeatPeriod: plan.RepeatPeriod.ValueInt64(),
+	}
+	diags.Append(plan.URLs.ElementsAs(ctx, &hook.URLs, false)...)
+	if !plan.ActiveBetween.IsNull() {
+		diags.Append(plan.ActiveBetween.ElementsAs(ctx, &hook.ActiveBetween, false)...)
+	}
+	return hook
+}
+
+// readWebhook refreshes state from the device. It returns false if the
+// webhook no longer exists.
+func readWebhook(ctx context.Context, client *resty.Client, state *webhookResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var result struct {
+		Hooks []webhook `json:"hooks"`
+	}
+	if err := callRPC(client, "Webhook.List", nil, &result); err != nil {
+		diags.AddError("Failed to query webhooks", err.Error())
+		return false, diags
+	}
+
+	for _, hook := range result.Hooks {
+		if hook.ID == nil || *hook.ID != state.ID.ValueInt32() {
+			continue
+		}
+		var d diag.Diagnostics
+		state.CID = types.Int32Value(hook.CID)
+		state.Event = types.StringValue(hook.Event)
+		state.Name = nonEmptyStringValue(hook.Name)
+		state.Enable = types.BoolValue(hook.Enable)
+		state.Condition = nonEmptyStringValue(hook.Condition)
+		state.RepeatPeriod = types.Int64Value(hook.RepeatPeriod)
+		state.URLs, d = types.ListValueFrom(ctx, types.StringType, hook.URLs)
+		diags.Append(d...)
+		state.ActiveBetween = types.ListNull(types.StringType)
+		if len(hook.ActiveBetween) > 0 {
+			state.ActiveBetween, d = types.ListValueFrom(ctx, types.StringType, hook.ActiveBetween)
+			diags.Append(d...)
+		}
+		return true, diags
+	}
+	return false, diags
+}
+
+// nonEmptyStringValue returns s, or null if s is nil or empty.
+func nonEmptyStringValue(s *string) types.String {
+	if s == nil || *s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*s)
+}
+
+func (c *webhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state webhookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	found, diags := readWebhook(ctx, client, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func setWebhook(ctx context.Context, client *resty.Client, plan *webhookResourceModel, diags *diag.Diagnostics) {
+	hook := webhookFromPlan(ctx, plan, diags)
+	if diags.HasError() {
+		return
+	}
+	method := "Webhook.Create"
+	if !plan.ID.IsUnknown() && !plan.ID.IsNull() {
+		id := plan.ID.ValueInt32()
+		hook.ID = &id
+		method = "Webhook.Update"
+	}
+
+	var result struct {
+		ID *int32 `json:"id"`
+	}
+	if err := callRPC(client, method, hook, &result); err != nil {
+		diags.AddError("Failed to set webhook", err.Error())
+		return
+	}
+	if result.ID != nil {
+		plan.ID = types.Int32Value(*result.ID)
+	}
+	_, d := readWebhook(ctx, client, plan)
+	diags.Append(d...)
+}
+
+func (c *webhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan webhookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	setWebhook(ctx, client, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *webhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan webhookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, plan.IP.ValueString())
+	defer client.Close()
+
+	setWebhook(ctx, client, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *webhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateIPAndID(ctx, req, resp, "id", "webhook")
+}
+
+func (c *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state webhookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newDeviceClient(c.credentials, state.IP.ValueString())
+	defer client.Close()
+
+	if err := callRPC(client, "Webhook.Delete", map[string]any{"id": state.ID.ValueInt32()}, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete webhook", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
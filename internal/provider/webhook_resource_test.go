@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupportedWebhookEvents(t *testing.T) {
+	for name, result := range map[string]string{
+		"types":      `{"types": {"switch.on": {}, "switch.off": {}, "input.toggle_on": {"attrs": []}}}`,
+		"hook_types": `{"hook_types": ["switch.on", "switch.off", "input.toggle_on"]}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			client := newFakeDevice(t, fakeResult(result))
+
+			events, err := supportedWebhookEvents(client)
+			require.NoError(t, err)
+			require.Equal(t, []string{"input.toggle_on", "switch.off", "switch.on"}, events)
+		})
+	}
+}
+
+// fakeWebhookDevice answers the Webhook methods, keeping the hooks and the
+// params of the last Webhook.Create or Webhook.Update.
+type fakeWebhookDevice struct {
+	hooks     []webhook
+	nextID    int32
+	setParams map[string]any
+}
+
+func (d *fakeWebhookDevice) handle(method string, raw json.RawMessage) (any, *rpcError) {
+	var hook webhook
+	_ = json.Unmarshal(raw, &hook)
+	if method == "Webhook.Create" || method == "Webhook.Update" {
+		d.setParams = nil
+		_ = json.Unmarshal(raw, &d.setParams)
+	}
+
+	index := slices.IndexFunc(d.hooks, func(h webhook) bool { return hook.ID != nil && *h.ID == *hook.ID })
+	switch method {
+	case "Webhook.Create":
+		id := d.nextID
+		d.nextID++
+		hook.ID = &id
+		d.hooks = append(d.hooks, hook)
+		return map[string]any{"id": id, "rev": 1}, nil
+	case "Webhook.Update":
+		if index < 0 {
+			return nil, &rpcError{Code: rpcErrInvalidArgument, Message: "not found"}
+		}
+		d.hooks[index] = hook
+		return map[string]any{"rev": 2}, nil
+	case "Webhook.Delete":
+		if index < 0 {
+			return nil, &rpcError{Code: rpcErrInvalidArgument, Message: "not found"}
+		}
+		d.hooks = slices.Delete(d.hooks, index, index+1)
+		return map[string]any{"rev": 3}, nil
+	case "Webhook.List":
+		return map[string]any{"hooks": d.hooks, "rev": 1}, nil
+	}
+	return nil, &rpcError{Code: 404, Message: "No handler for " + method}
+}
+
+func TestWebhookLifecycle(t *testing.T) {
+	ctx := context.Background()
+	device := &fakeWebhookDevice{nextID: 1}
+	ip := fakeDeviceIP(t, device.handle)
+	res := NewWebhookResource()
+
+	stringList := tftypes.List{ElementType: tftypes.String}
+	list := func(values ...string) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(values))
+		for _, value := range values {
+			elements = append(elements, tftypes.NewValue(tftypes.String, value))
+		}
+		return tftypes.NewValue(stringList, elements)
+	}
+	plan := func(id any, condition, activeBetween tftypes.Value) tfsdk.Plan {
+		config := resourceConfig(t, res, map[string]tftypes.Value{
+			"ip":             tftypes.NewValue(tftypes.String, ip),
+			"id":             tftypes.NewValue(tftypes.Number, id),
+			"cid":            tftypes.NewValue(tftypes.Number, 0),
+			"event":          tftypes.NewValue(tftypes.String, "switch.on"),
+			"enable":         tftypes.NewValue(tftypes.Bool, true),
+			"urls":           list("http://192.0.2.10/on"),
+			"condition":      condition,
+			"repeat_period":  tftypes.NewValue(tftypes.Number, 0),
+			"active_between": activeBetween,
+		})
+		return tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}
+	}
+
+	createPlan := plan(tftypes.UnknownValue, tftypes.NewValue(tftypes.String, "ev.tC > 30"), list("22:00", "06:00"))
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: createPlan.Schema, Raw: tftypes.NewValue(createPlan.Raw.Type(), nil)}}
+	res.Create(ctx, resource.CreateRequest{Plan: createPlan}, &createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	var state webhookResourceModel
+	require.False(t, createResp.State.Get(ctx, &state).HasError())
+	require.Equal(t, int32(1), state.ID.ValueInt32())
+	require.Equal(t, "ev.tC > 30", state.Condition.ValueString())
+	require.Len(t, state.ActiveBetween.Elements(), 2)
+	require.NotContains(t, device.setParams, "id")
+
+	// Removing condition and active_between clears them on the device.
+	updatePlan := plan(1, tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(stringList, nil))
+	updateResp := resource.UpdateResponse{State: createResp.State}
+	res.Update(ctx, resource.UpdateRequest{Plan: updatePlan, State: createResp.State}, &updateResp)
+	require.False(t, updateResp.Diagnostics.HasError(), updateResp.Diagnostics)
+	require.Len(t, device.hooks, 1)
+	require.Contains(t, device.setParams, "condition")
+	require.Nil(t, device.setParams["condition"])
+	require.Contains(t, device.setParams, "active_between")
+	require.Nil(t, device.setParams["active_between"])
+
+	readResp := resource.ReadResponse{State: updateResp.State}
+	res.Read(ctx, resource.ReadRequest{State: updateResp.State}, &readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	require.True(t, readResp.State.Raw.Equal(updatePlan.Raw))
+
+	deleteResp := resource.DeleteResponse{State: readResp.State}
+	res.Delete(ctx, resource.DeleteRequest{State: readResp.State}, &deleteResp)
+	require.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+	require.Empty(t, device.hooks)
+
+	// A webhook deleted outside of Terraform drops out of state.
+	readResp = resource.ReadResponse{State: updateResp.State}
+	res.Read(ctx, resource.ReadRequest{State: updateResp.State}, &readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	require.True(t, readResp.State.Raw.IsNull())
+}
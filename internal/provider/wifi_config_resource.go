@@ -0,0 +1,637 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &wifiConfigResource{}
+	_ resource.ResourceWithConfigure   = &wifiConfigResource{}
+	_ resource.ResourceWithImportState = &wifiConfigResource{}
+)
+
+func NewWifiConfigResource() resource.Resource {
+	return &wifiConfigResource{}
+}
+
+type wifiConfigResourceModel struct {
+	IP            types.String   `tfsdk:"ip"`
+	Sta           *wifiStaModel  `tfsdk:"sta"`
+	Sta1          *wifiStaModel  `tfsdk:"sta1"`
+	AP            *wifiAPModel   `tfsdk:"ap"`
+	Roam          *wifiRoamModel `tfsdk:"roam"`
+	PassWOVersion types.Int64    `tfsdk:"pass_wo_version"`
+}
+
+type wifiStaModel struct {
+	Enable     types.Bool   `tfsdk:"enable"`
+	SSID       types.String `tfsdk:"ssid"`
+	Pass       types.String `tfsdk:"pass"`
+	IsOpen     types.Bool   `tfsdk:"is_open"`
+	IPv4Mode   types.String `tfsdk:"ipv4mode"`
+	IP         types.String `tfsdk:"ip"`
+	Netmask    types.String `tfsdk:"netmask"`
+	GW         types.String `tfsdk:"gw"`
+	Nameserver types.String `tfsdk:"nameserver"`
+}
+
+type wifiAPModel struct {
+	Enable        types.Bool   `tfsdk:"enable"`
+	SSID          types.String `tfsdk:"ssid"`
+	Pass          types.String `tfsdk:"pass"`
+	IsOpen        types.Bool   `tfsdk:"is_open"`
+	RangeExtender types.Bool   `tfsdk:"range_extender"`
+}
+
+type wifiRoamModel struct {
+	RSSIThr  types.Int64 `tfsdk:"rssi_thr"`
+	Interval types.Int64 `tfsdk:"interval"`
+}
+
+// wifiConfig mirrors the config object of Wifi.GetConfig / Wifi.SetConfig.
+type wifiConfig struct {
+	AP   *wifiAPConfig   `json:"ap,omitempty"`
+	Sta  *wifiStaConfig  `json:"sta,omitempty"`
+	Sta1 *wifiStaConfig  `json:"sta1,omitempty"`
+	Roam *wifiRoamConfig `json:"roam,omitempty"`
+}
+
+type wifiStaConfig struct {
+	SSID       *string `json:"ssid,omitempty"`
+	Pass       *string `json:"pass,omitempty"`
+	IsOpen     *bool   `json:"is_open,omitempty"`
+	Enable     *bool   `json:"enable,omitempty"`
+	IPv4Mode   *string `json:"ipv4mode,omitempty"`
+	IP         *string `json:"ip,omitempty"`
+	Netmask    *string `json:"netmask,omitempty"`
+	GW         *string `json:"gw,omitempty"`
+	Nameserver *string `json:"nameserver,omitempty"`
+}
+
+type wifiAPConfig struct {
+	SSID          *string                  `json:"ssid,omitempty"`
+	Pass          *string                  `json:"pass,omitempty"`
+	IsOpen        *bool                    `json:"is_open,omitempty"`
+	Enable        *bool                    `json:"enable,omitempty"`
+	RangeExtender *wifiRangeExtenderConfig `json:"range_extender,omitempty"`
+}
+
+type wifiRangeExtenderConfig struct {
+	Enable *bool `json:"enable,omitempty"`
+}
+
+type wifiRoamConfig struct {
+	RSSIThr  *int64 `json:"rssi_thr,omitempty"`
+	Interval *int64 `json:"interval,omitempty"`
+}
+
+// wifiStatus is the result of Wifi.GetStatus.
+type wifiStatus struct {
+	StaIP *string `json:"sta_ip"`
+	SSID  *string `json:"ssid"`
+}
+
+type wifiConfigResource struct {
+	credentials *deviceCredentials
+}
+
+func (c *wifiConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wifi_config"
+}
+
+func (c *wifiConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	c.credentials = providerCredentials(req.ProviderData, &resp.Diagnostics)
+}
+
+func wifiStaSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"enable": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "True if the station is enabled.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ssid": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "SSID of the network to connect to.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"pass": schema.StringAttribute{
+			Optional:            true,
+			Sensitive:           true,
+			WriteOnly:           true,
+			MarkdownDescription: "Password of the network. Write-only, it is never read back from the device or stored in state. Bump `pass_wo_version` to push a changed password.",
+		},
+		"is_open": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "True if the network is open, i.e. has no password.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipv4mode": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "IPv4 addressing mode. Range of values: dhcp, static.",
+			Validators: []validator.String{
+				stringvalidator.OneOf("dhcp", "static"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ip": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Static IP address (only used when `ipv4mode` is static).",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"netmask": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Network mask (only used when `ipv4mode` is static).",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"gw": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Gateway IP address (only used when `ipv4mode` is static).",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"nameserver": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Name server IP address (only used when `ipv4mode` is static).",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (c *wifiConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the Wi-Fi configuration of a Shelly device. Only the sections present in the configuration are managed. " +
+			"Changing the station settings usually makes the device drop off the network for a while; the provider waits for it to come back before reading the configuration. " +
+			"If the change moves the device to an address that cannot be predicted, for example by switching to DHCP, the provider does not wait and warns instead. " +
+			"Importing reads all sections; the ones left out of the configuration are dropped from state on the next apply without changing the device.",
+		Attributes: map[string]schema.Attribute{
+			"ip": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The IP address of the Shelly device.",
+			},
+			"sta": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Primary Wi-Fi station configuration.",
+				Attributes:          wifiStaSchemaAttributes(),
+			},
+			"sta1": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Fallback Wi-Fi station configuration.",
+				Attributes:          wifiStaSchemaAttributes(),
+			},
+			"ap": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Access point configuration.",
+				Attributes: map[string]schema.Attribute{
+					"enable": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "True if the access point is enabled.",
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"ssid": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "SSID of the access point. Assigned by the device.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"pass": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+						MarkdownDescription: "Password of the access point. Write-only, it is never read back from the device or stored in state. Bump `pass_wo_version` to push a changed password.",
+					},
+					"is_open": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "True if the access point is open, i.e. has no password.",
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"range_extender": schema.BoolAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "True if the access point acts as a range extender for the station network.",
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"roam": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Roaming configuration.",
+				Attributes: map[string]schema.Attribute{
+					"rssi_thr": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "RSSI threshold (in dBm) below which the device starts scanning for a better access point.",
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"interval": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Scan interval in seconds. 0 disables roaming.",
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"pass_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Arbitrary version number of the write-only passwords. Change it to push the passwords to the device again.",
+			},
+		},
+	}
+}
+
+func readWifiConfig(credentials *deviceCredentials, ip string, state *wifiConfigResourceModel) error {
+	client := newDeviceClient(credentials, ip)
+	defer client.Close()
+
+	var config wifiConfig
+	if err := callRPC(client, "Wifi.GetConfig", nil, &config); err != nil {
+		return err
+	}
+
+	// Only sections already tracked in state are refreshed; the others are not
+	// managed by this resource.
+	if state.Sta != nil && config.Sta != nil {
+		readWifiSta(state.Sta, config.Sta)
+	}
+	if state.Sta1 != nil && config.Sta1 != nil {
+		readWifiSta(state.Sta1, config.Sta1)
+	}
+	if state.AP != nil && config.AP != nil {
+		state.AP.Enable = types.BoolPointerValue(config.AP.Enable)
+		state.AP.SSID = types.StringPointerValue(config.AP.SSID)
+		state.AP.IsOpen = types.BoolPointerValue(config.AP.IsOpen)
+		if config.AP.RangeExtender != nil {
+			state.AP.RangeExtender = types.BoolPointerValue(config.AP.RangeExtender.Enable)
+		} else {
+			state.AP.RangeExtender = types.BoolNull()
+		}
+	}
+	if state.Roam != nil && config.Roam != nil {
+		state.Roam.RSSIThr = types.Int64PointerValue(config.Roam.RSSIThr)
+		state.Roam.Interval = types.Int64PointerValue(config.Roam.Interval)
+	}
+	return nil
+}
+
+func readWifiSta(state *wifiStaModel, config *wifiStaConfig) {
+	state.Enable = types.BoolPointerValue(config.Enable)
+	state.SSID = types.StringPointerValue(config.SSID)
+	state.IsOpen = types.BoolPointerValue(config.IsOpen)
+	state.IPv4Mode = types.StringPointerValue(config.IPv4Mode)
+	state.IP = types.StringPointerValue(config.IP)
+	state.Netmask = types.StringPointerValue(config.Netmask)
+	state.GW = types.StringPointerValue(config.GW)
+	state.Nameserver = types.StringPointerValue(config.Nameserver)
+}
+
+func (c *wifiConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state wifiConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := readWifiConfig(c.credentials, state.IP.ValueString(), &state); err != nil {
+		resp.Diagnostics.AddError("Failed to query Wi-Fi config", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func wifiStaFromPlan(plan *wifiStaModel, config *wifiStaModel) *wifiStaConfig {
+	if plan == nil {
+		return nil
+	}
+	sta := &wifiStaConfig{
+		SSID:       stringPointer(plan.SSID),
+		IsOpen:     boolPointer(plan.IsOpen),
+		Enable:     boolPointer(plan.Enable),
+		IPv4Mode:   stringPointer(plan.IPv4Mode),
+		IP:         stringPointer(plan.IP),
+		Netmask:    stringPointer(plan.Netmask),
+		GW:         stringPointer(plan.GW),
+		Nameserver: stringPointer(plan.Nameserver),
+	}
+	// Write-only values are only available in the configuration.
+	if config != nil {
+		sta.Pass = stringPointer(config.Pass)
+	}
+	return sta
+}
+
+// setWifiConfig applies plan to the device and returns the address the device
+// is reached at afterwards. Passwords are taken from config since write-only
+// values are never part of the plan. The device may drop off the network
+// while applying the change, so a failed connection is not treated as an
+// error as long as the device comes back afterwards. If the change moves the
+// device to an address that cannot be predicted, it returns "" without
+// waiting for the device, and fills plan from the config that was applied.
+func setWifiConfig(ctx context.Context, credentials *deviceCredentials, plan *wifiConfigResourceModel, config wifiConfigResourceModel, diags *diag.Diagnostics) (string, error) {
+	var wifi wifiConfig
+	wifi.Sta = wifiStaFromPlan(plan.Sta, config.Sta)
+	wifi.Sta1 = wifiStaFromPlan(plan.Sta1, config.Sta1)
+	if plan.AP != nil {
+		wifi.AP = &wifiAPConfig{
+			IsOpen: boolPointer(plan.AP.IsOpen),
+			Enable: boolPointer(plan.AP.Enable),
+		}
+		if config.AP != nil {
+			wifi.AP.Pass = stringPointer(config.AP.Pass)
+		}
+		if rangeExtender := boolPointer(plan.AP.RangeExtender); rangeExtender != nil {
+			wifi.AP.RangeExtender = &wifiRangeExtenderConfig{Enable: rangeExtender}
+		}
+	}
+	if plan.Roam != nil {
+		wifi.Roam = &wifiRoamConfig{
+			RSSIThr:  int64Pointer(plan.Roam.RSSIThr),
+			Interval: int64Pointer(plan.Roam.Interval),
+		}
+	}
+
+	address := plan.IP.ValueString()
+	client := newDeviceClient(credentials, address)
+	defer client.Close()
+	client.SetTimeout(deviceRequestTimeout)
+
+	var current wifiConfig
+	var status wifiStatus
+	if err := callRPC(client, "Wifi.GetConfig", nil, &current); err != nil {
+		diags.AddError("Failed to query Wi-Fi config", err.Error())
+		return "", err
+	}
+	if err := callRPC(client, "Wifi.GetStatus", nil, &status); err != nil {
+		diags.AddError("Failed to query Wi-Fi status", err.Error())
+		return "", err
+	}
+
+	err := callRPC(client, "Wifi.SetConfig", map[string]any{"config": wifi}, nil)
+	if err != nil && isRPCError(err) {
+		diags.AddError("Failed to set Wi-Fi config", err.Error())
+		return "", err
+	}
+
+	if newAddress, known := wifiAddressAfter(address, current, status, wifi); newAddress != address {
+		diags.AddWarning("Device address changed",
+			fmt.Sprintf("The Wi-Fi change may have moved the device away from %s; update ip accordingly.", address))
+		if !known {
+			resolveUnknownWifiSettings(plan, current)
+			return "", nil
+		}
+		address = newAddress
+	}
+
+	if err := waitForDevice(ctx, credentials, address, deviceReconnectTimeout); err != nil {
+		diags.AddError("Device did not come back after Wi-Fi config change", err.Error())
+		return "", err
+	}
+	return address, nil
+}
+
+// wifiAddressAfter returns the address the device is reached at after
+// desired is applied on top of current, and whether it is known. status is
+// the device's Wi-Fi status before the change. Only the station the device
+// is connected through matters; a change of its SSID, switching it to DHCP
+// or disabling it leaves the new address unknown.
+func wifiAddressAfter(address string, current wifiConfig, status wifiStatus, desired wifiConfig) (string, bool) {
+	if status.StaIP == nil || *status.StaIP != address {
+		// The device is not reached over Wi-Fi.
+		return address, true
+	}
+	// The device falls back to sta1 if it cannot connect with sta.
+	currentSta, desiredSta := current.Sta, desired.Sta
+	if !wifiStaConnected(current.Sta, status) && wifiStaConnected(current.Sta1, status) {
+		currentSta, desiredSta = current.Sta1, desired.Sta1
+	}
+	if desiredSta == nil {
+		return address, true
+	}
+	if currentSta == nil {
+		currentSta = &wifiStaConfig{}
+	}
+
+	if desiredSta.Enable != nil && !*desiredSta.Enable {
+		return "", false
+	}
+	if desiredSta.SSID != nil && (currentSta.SSID == nil || *desiredSta.SSID != *currentSta.SSID) {
+		return "", false
+	}
+	mode, ip := currentSta.IPv4Mode, currentSta.IP
+	if desiredSta.IPv4Mode != nil {
+		mode = desiredSta.IPv4Mode
+	}
+	if desiredSta.IP != nil {
+		ip = desiredSta.IP
+	}
+	switch {
+	case mode == nil:
+		return address, true
+	case *mode == "static" && ip != nil:
+		return *ip, true
+	case *mode == "dhcp" && currentSta.IPv4Mode != nil && *currentSta.IPv4Mode == "static":
+		return "", false
+	}
+	return address, true
+}
+
+// wifiStaConnected reports whether status shows a connection to the network
+// of sta.
+func wifiStaConnected(sta *wifiStaConfig, status wifiStatus) bool {
+	return sta != nil && sta.SSID != nil && status.SSID != nil && *sta.SSID == *status.SSID
+}
+
+// resolveUnknownWifiSettings fills the settings plan leaves to the device
+// from current. They are not sent, so the device keeps them.
+func resolveUnknownWifiSettings(plan *wifiConfigResourceModel, current wifiConfig) {
+	resolveUnknownWifiSta(plan.Sta, current.Sta)
+	resolveUnknownWifiSta(plan.Sta1, current.Sta1)
+	if plan.AP != nil {
+		ap := current.AP
+		if ap == nil {
+			ap = &wifiAPConfig{}
+		}
+		if plan.AP.Enable.IsUnknown() {
+			plan.AP.Enable = types.BoolPointerValue(ap.Enable)
+		}
+		if plan.AP.SSID.IsUnknown() {
+			plan.AP.SSID = types.StringPointerValue(ap.SSID)
+		}
+		if plan.AP.IsOpen.IsUnknown() {
+			plan.AP.IsOpen = types.BoolPointerValue(ap.IsOpen)
+		}
+		if plan.AP.RangeExtender.IsUnknown() {
+			plan.AP.RangeExtender = types.BoolNull()
+			if ap.RangeExtender != nil {
+				plan.AP.RangeExtender = types.BoolPointerValue(ap.RangeExtender.Enable)
+			}
+		}
+	}
+	if plan.Roam != nil {
+		roam := current.Roam
+		if roam == nil {
+			roam = &wifiRoamConfig{}
+		}
+		if plan.Roam.RSSIThr.IsUnknown() {
+			plan.Roam.RSSIThr = types.Int64PointerValue(roam.RSSIThr)
+		}
+		if plan.Roam.Interval.IsUnknown() {
+			plan.Roam.Interval = types.Int64PointerValue(roam.Interval)
+		}
+	}
+}
+
+func resolveUnknownWifiSta(plan *wifiStaModel, current *wifiStaConfig) {
+	if plan == nil {
+		return
+	}
+	if current == nil {
+		current = &wifiStaConfig{}
+	}
+	var device wifiStaModel
+	readWifiSta(&device, current)
+	if plan.Enable.IsUnknown() {
+		plan.Enable = device.Enable
+	}
+	if plan.SSID.IsUnknown() {
+		plan.SSID = device.SSID
+	}
+	if plan.IsOpen.IsUnknown() {
+		plan.IsOpen = device.IsOpen
+	}
+	if plan.IPv4Mode.IsUnknown() {
+		plan.IPv4Mode = device.IPv4Mode
+	}
+	if plan.IP.IsUnknown() {
+		plan.IP = device.IP
+	}
+	if plan.Netmask.IsUnknown() {
+		plan.Netmask = device.Netmask
+	}
+	if plan.GW.IsUnknown() {
+		plan.GW = device.GW
+	}
+	if plan.Nameserver.IsUnknown() {
+		plan.Nameserver = device.Nameserver
+	}
+}
+
+func (c *wifiConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config wifiConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	address, err := setWifiConfig(ctx, c.credentials, &plan, config, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	// If the device could not be followed, plan holds what was applied.
+	if address != "" {
+		if err := readWifiConfig(c.credentials, address, &plan); err != nil {
+			resp.Diagnostics.AddError("Failed to query Wi-Fi config", err.Error())
+			return
+		}
+	}
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *wifiConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, config wifiConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	address, err := setWifiConfig(ctx, c.credentials, &plan, config, &resp.Diagnostics)
+	if err != nil {
+		return
+	}
+	// If the device could not be followed, plan holds what was applied.
+	if address != "" {
+		if err := readWifiConfig(c.credentials, address, &plan); err != nil {
+			resp.Diagnostics.AddError("Failed to query Wi-Fi config", err.Error())
+			return
+		}
+	}
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *wifiConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Read only refreshes the sections in state, so all of them are read here.
+	state := wifiConfigResourceModel{
+		IP:   types.StringValue(req.ID),
+		Sta:  &wifiStaModel{},
+		Sta1: &wifiStaModel{},
+		AP:   &wifiAPModel{},
+		Roam: &wifiRoamModel{},
+	}
+	if err := readWifiConfig(c.credentials, req.ID, &state); err != nil {
+		resp.Diagnostics.AddError("Failed to query Wi-Fi config", err.Error())
+		return
+	}
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *wifiConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Removing the Wi-Fi config would disconnect the device, so it is left as is.
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+const fakeWifiConfig = `{
+	"ap": {"ssid": "ShellyPlus1PM-A8032AB12345", "is_open": true, "enable": false, "range_extender": {"enable": false}},
+	"sta": {"ssid": "Home", "is_open": false, "enable": true, "ipv4mode": "static", "ip": "10.0.0.5", "netmask": "255.255.255.0", "gw": "10.0.0.1", "nameserver": null},
+	"sta1": {"ssid": null, "is_open": true, "enable": false, "ipv4mode": "dhcp", "ip": null, "netmask": null, "gw": null, "nameserver": null},
+	"roam": {"rssi_thr": -80, "interval": 60}
+}`
+
+func TestWifiAddressAfter(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+	disabled := false
+	current := wifiConfig{
+		Sta:  &wifiStaConfig{SSID: ptr("Home"), IPv4Mode: ptr("static"), IP: ptr("10.0.0.5")},
+		Sta1: &wifiStaConfig{SSID: ptr("Backup"), IPv4Mode: ptr("dhcp")},
+	}
+	onSta := wifiStatus{StaIP: ptr("10.0.0.5"), SSID: ptr("Home")}
+	onSta1 := wifiStatus{StaIP: ptr("10.0.1.7"), SSID: ptr("Backup")}
+
+	for name, tc := range map[string]struct {
+		address string
+		status  wifiStatus
+		desired wifiConfig
+		want    string
+		known   bool
+	}{
+		"not on Wi-Fi":       {"10.0.0.5", wifiStatus{}, wifiConfig{Sta: &wifiStaConfig{IPv4Mode: ptr("dhcp")}}, "10.0.0.5", true},
+		"sta untouched":      {"10.0.0.5", onSta, wifiConfig{Roam: &wifiRoamConfig{}}, "10.0.0.5", true},
+		"same static":        {"10.0.0.5", onSta, wifiConfig{Sta: &wifiStaConfig{GW: ptr("10.0.0.254")}}, "10.0.0.5", true},
+		"new static":         {"10.0.0.5", onSta, wifiConfig{Sta: &wifiStaConfig{IP: ptr("10.0.0.6")}}, "10.0.0.6", true},
+		"to DHCP":            {"10.0.0.5", onSta, wifiConfig{Sta: &wifiStaConfig{IPv4Mode: ptr("dhcp")}}, "", false},
+		"disabled":           {"10.0.0.5", onSta, wifiConfig{Sta: &wifiStaConfig{Enable: &disabled}}, "", false},
+		"new network":        {"10.0.0.5", onSta, wifiConfig{Sta: &wifiStaConfig{SSID: ptr("Office")}}, "", false},
+		"sta1 untouched":     {"10.0.1.7", onSta1, wifiConfig{Sta: &wifiStaConfig{IP: ptr("10.0.0.6")}}, "10.0.1.7", true},
+		"sta1 to static":     {"10.0.1.7", onSta1, wifiConfig{Sta1: &wifiStaConfig{IPv4Mode: ptr("static"), IP: ptr("10.0.1.8")}}, "10.0.1.8", true},
+		"sta1 stays on DHCP": {"10.0.1.7", onSta1, wifiConfig{Sta1: &wifiStaConfig{IPv4Mode: ptr("dhcp")}}, "10.0.1.7", true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			address, known := wifiAddressAfter(tc.address, current, tc.status, tc.desired)
+			require.Equal(t, tc.want, address)
+			require.Equal(t, tc.known, known)
+		})
+	}
+}
+
+func TestSetWifiConfigSwitchToDHCP(t *testing.T) {
+	var ip string
+	var sent bool
+	ip = fakeDeviceIP(t, func(method string, _ json.RawMessage) (any, *rpcError) {
+		switch method {
+		case "Wifi.GetConfig":
+			return json.RawMessage(fakeWifiConfig), nil
+		case "Wifi.GetStatus":
+			// The device is reached over Wi-Fi.
+			return map[string]any{"sta_ip": ip, "ssid": "Home", "status": "got ip"}, nil
+		case "Wifi.SetConfig":
+			sent = true
+			return map[string]any{"restart_required": false}, nil
+		}
+		t.Errorf("unexpected call to %s", method)
+		return nil, &rpcError{Code: 404, Message: "No handler for " + method}
+	})
+
+	plan := wifiConfigResourceModel{
+		IP: types.StringValue(ip),
+		Sta: &wifiStaModel{
+			Enable:     types.BoolUnknown(),
+			SSID:       types.StringValue("Home"),
+			IsOpen:     types.BoolUnknown(),
+			IPv4Mode:   types.StringValue("dhcp"),
+			IP:         types.StringUnknown(),
+			Netmask:    types.StringUnknown(),
+			GW:         types.StringUnknown(),
+			Nameserver: types.StringUnknown(),
+		},
+		Roam: &wifiRoamModel{RSSIThr: types.Int64Value(-70), Interval: types.Int64Unknown()},
+	}
+	var diags diag.Diagnostics
+	// The new address cannot be predicted, so there is no waiting for the
+	// device.
+	address, err := setWifiConfig(context.Background(), nil, &plan, wifiConfigResourceModel{}, &diags)
+	require.NoError(t, err)
+	require.False(t, diags.HasError(), diags)
+	require.Len(t, diags.Warnings(), 1)
+	require.True(t, sent)
+	require.Empty(t, address)
+
+	require.Equal(t, "dhcp", plan.Sta.IPv4Mode.ValueString())
+	require.True(t, plan.Sta.Enable.ValueBool())
+	require.Equal(t, "10.0.0.5", plan.Sta.IP.ValueString())
+	require.True(t, plan.Sta.Nameserver.IsNull())
+	require.Equal(t, int64(-70), plan.Roam.RSSIThr.ValueInt64())
+	require.Equal(t, int64(60), plan.Roam.Interval.ValueInt64())
+}
+
+func TestWifiConfigImportState(t *testing.T) {
+	ip := fakeDeviceIP(t, fakeResult(fakeWifiConfig))
+
+	res := NewWifiConfigResource()
+	empty := resourceConfig(t, res, nil)
+	resp := resource.ImportStateResponse{State: tfsdk.State{
+		Schema: empty.Schema,
+		Raw:    tftypes.NewValue(empty.Raw.Type(), nil),
+	}}
+	res.(resource.ResourceWithImportState).ImportState(context.Background(), resource.ImportStateRequest{ID: ip}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state wifiConfigResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	require.Equal(t, ip, state.IP.ValueString())
+	require.Equal(t, "Home", state.Sta.SSID.ValueString())
+	require.Equal(t, "10.0.0.5", state.Sta.IP.ValueString())
+	require.True(t, state.Sta.Pass.IsNull())
+	require.Equal(t, "dhcp", state.Sta1.IPv4Mode.ValueString())
+	require.Equal(t, "ShellyPlus1PM-A8032AB12345", state.AP.SSID.ValueString())
+	require.False(t, state.AP.RangeExtender.ValueBool())
+	require.Equal(t, int64(-80), state.Roam.RSSIThr.ValueInt64())
+}